@@ -3,6 +3,8 @@ package fluentsql
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"reflect"
 
 	"github.com/biyonik/go-fluent-sql/dialect"
 )
@@ -34,6 +36,9 @@ type Builder struct {
 	grammar  dialect.Grammar
 	scanner  Scanner
 
+	// Owning transaction (nil outside a transaction)
+	tx *Transaction
+
 	// Table name and alias
 	table      string
 	tableAlias string
@@ -53,6 +58,31 @@ type Builder struct {
 	limit  *int
 	offset *int
 
+	// Row locking (FOR UPDATE / FOR SHARE)
+	lock *dialect.LockClause
+
+	// RETURNING columns (INSERT/UPDATE/DELETE)
+	returning []string
+
+	// Query logging
+	logger Logger
+	debug  bool
+	redact []string
+
+	// Table prefix and the tables excluded from it
+	prefix     string
+	unprefixed []string
+
+	// Execution middleware
+	interceptors []Interceptor
+
+	// Read/write splitting
+	cluster    *Cluster
+	usePrimary bool
+
+	// Retry policy for standalone writes
+	retry RetryPolicy
+
 	// Accumulated error
 	err error
 }
@@ -263,7 +293,8 @@ func (b *Builder) WhereNotLike(column string, pattern string) *Builder {
 	return b
 }
 
-// WhereRaw, ham SQL WHERE ifadesi ekler.
+// WhereRaw, ham SQL WHERE ifadesi ekler. Bağlamalar WithRedactedColumns
+// kalıplarıyla maskelenmez; gizli değerler Sensitive(v) ile verilmelidir.
 func (b *Builder) WhereRaw(sqlExpr string, bindings ...any) *Builder {
 	b.wheres = append(b.wheres, dialect.WhereClause{
 		Type:     dialect.WhereTypeRaw,
@@ -353,6 +384,33 @@ func (b *Builder) WhereDay(column string, value int) *Builder {
 	return b
 }
 
+// WhereFullText, tam metin arama koşulu ekler.
+// Arama metni parametre olarak bağlanır; mod belirtilmezse boolean mod kullanılır.
+//
+// Örnek:
+//
+//	db.Table("products").
+//	    WhereFullText([]string{"name", "description"}, "+kablosuz -kulaklık").
+//	    OrderByRelevance([]string{"name", "description"}, "+kablosuz -kulaklık")
+func (b *Builder) WhereFullText(columns []string, query string, opts ...dialect.FullTextOptions) *Builder {
+	b.wheres = append(b.wheres, dialect.WhereClause{
+		Type:     dialect.WhereTypeFullText,
+		Boolean:  dialect.WhereBooleanAnd,
+		FullText: newFullTextClause(columns, query, opts),
+	})
+	return b
+}
+
+// OrWhereFullText, OR bağlacıyla tam metin arama koşulu ekler.
+func (b *Builder) OrWhereFullText(columns []string, query string, opts ...dialect.FullTextOptions) *Builder {
+	b.wheres = append(b.wheres, dialect.WhereClause{
+		Type:     dialect.WhereTypeFullText,
+		Boolean:  dialect.WhereBooleanOr,
+		FullText: newFullTextClause(columns, query, opts),
+	})
+	return b
+}
+
 // Join, INNER JOIN ekler.
 func (b *Builder) Join(table, first, operator, second string) *Builder {
 	b.joins = append(b.joins, dialect.JoinClause{
@@ -398,6 +456,76 @@ func (b *Builder) CrossJoin(table string) *Builder {
 	return b
 }
 
+// JoinSub, bir alt sorguyu alias ile INNER JOIN olarak ekler.
+//
+// Örnek:
+//
+//	latest := db.Table("orders").Select("user_id", "MAX(created_at) AS last_order").GroupBy("user_id")
+//	db.Table("users").JoinSub(latest, "lo", "lo.user_id", "=", "users.id")
+func (b *Builder) JoinSub(sub *Builder, alias, first, operator, second string) *Builder {
+	return b.joinSub(dialect.JoinInner, sub, alias, first, operator, second)
+}
+
+// LeftJoinSub, bir alt sorguyu alias ile LEFT JOIN olarak ekler.
+func (b *Builder) LeftJoinSub(sub *Builder, alias, first, operator, second string) *Builder {
+	return b.joinSub(dialect.JoinLeft, sub, alias, first, operator, second)
+}
+
+// UpdateFrom, UPDATE sorgusunu bir alt sorgunun sonuçlarıyla eşleştirir.
+// MySQL'de "UPDATE t INNER JOIN (SELECT ...) AS alias ON ... SET ..." olarak derlenir;
+// SET değerlerinde alt sorgu kolonlarına Raw ile başvurulabilir.
+//
+// Örnek:
+//
+//	totals := db.Table("orders").Select("user_id", "SUM(total) AS spent").GroupBy("user_id")
+//	db.Table("users").
+//	    UpdateFrom(totals, "t", "t.user_id", "=", "users.id").
+//	    Update(map[string]any{"lifetime_value": fluentsql.Raw{SQL: "`t`.`spent`"}})
+func (b *Builder) UpdateFrom(sub *Builder, alias, first, operator, second string) *Builder {
+	return b.JoinSub(sub, alias, first, operator, second)
+}
+
+func (b *Builder) joinSub(joinType dialect.JoinType, sub *Builder, alias, first, operator, second string) *Builder {
+	if sub == nil {
+		b.err = ErrNilSubquery
+		return b
+	}
+	if sub.err != nil {
+		b.err = sub.err
+		return b
+	}
+	if sub.prefix == "" && b.prefix != "" {
+		// Bağlantısız oluşturulan alt sorgular dış sorgunun önekini devralır
+		sub = sub.Clone()
+		sub.prefix, sub.unprefixed = b.prefix, b.unprefixed
+	}
+	b.joins = append(b.joins, dialect.JoinClause{
+		Type:     joinType,
+		Alias:    alias,
+		First:    first,
+		Operator: operator,
+		Second:   second,
+		Subquery: sub,
+	})
+	return b
+}
+
+// Returning, INSERT/UPDATE/DELETE sonrasında döndürülecek kolonları ayarlar.
+// Kolon verilmezse tüm kolonlar ("*") döndürülür. Dönen satırlar
+// InsertReturning, UpdateReturning ve DeleteReturning ile taranır.
+//
+// InsertContext, UpdateContext ve DeleteContext bir hedef almadığı için
+// satırları taramaz; RETURNING cümlesi yine gramer üzerinden derlenir ve
+// dönen satırlar atılır. Satırları okumak için *Returning varyantlarını
+// kullanın.
+func (b *Builder) Returning(columns ...string) *Builder {
+	if len(columns) == 0 {
+		columns = []string{"*"}
+	}
+	b.returning = append([]string(nil), columns...)
+	return b
+}
+
 // OrderBy, ORDER BY ekler.
 func (b *Builder) OrderBy(column string, direction dialect.OrderDirection) *Builder {
 	b.orders = append(b.orders, dialect.OrderClause{
@@ -425,6 +553,16 @@ func (b *Builder) OrderByRaw(expr string) *Builder {
 	return b
 }
 
+// OrderByRelevance, WhereFullText ile aynı arama ifadesini kullanarak
+// sonuçları alaka skoruna göre azalan sırada sıralar.
+func (b *Builder) OrderByRelevance(columns []string, query string, opts ...dialect.FullTextOptions) *Builder {
+	b.orders = append(b.orders, dialect.OrderClause{
+		Direction: dialect.OrderDesc,
+		FullText:  newFullTextClause(columns, query, opts),
+	})
+	return b
+}
+
 // Latest, created_at DESC ile sıralar.
 func (b *Builder) Latest() *Builder {
 	return b.OrderByDesc("created_at")
@@ -495,6 +633,65 @@ func (b *Builder) ForPage(page, perPage int) *Builder {
 	return b.Limit(perPage).Offset(offset)
 }
 
+// LockForUpdate, okunan satırları yazma kilidiyle kilitler (SELECT ... FOR UPDATE).
+// Kilitli okumalar yalnızca bir Transaction içinde çalıştırılabilir. Satır
+// kilidi olmayan SQLite'ta kilit yok sayılır.
+//
+// Örnek (iş kuyruğu):
+//
+//	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+//	    return tx.Table("jobs").
+//	        Where("status", "=", "pending").
+//	        OrderByAsc("id").
+//	        Limit(10).
+//	        LockForUpdate().
+//	        SkipLocked().
+//	        GetContext(ctx, &jobs)
+//	})
+func (b *Builder) LockForUpdate() *Builder {
+	b.lock = &dialect.LockClause{Strength: dialect.LockUpdate}
+	return b
+}
+
+// SharedLock, okunan satırları paylaşımlı kilitle kilitler (SELECT ... FOR SHARE).
+func (b *Builder) SharedLock() *Builder {
+	b.lock = &dialect.LockClause{Strength: dialect.LockShare}
+	return b
+}
+
+// SkipLocked, başka bir transaction tarafından kilitlenmiş satırları atlar.
+// LockForUpdate veya SharedLock'tan sonra çağrılmalıdır.
+func (b *Builder) SkipLocked() *Builder {
+	if b.lock == nil {
+		b.err = ErrLockModifierWithoutLock
+		return b
+	}
+	b.lock.Wait = dialect.LockSkipLocked
+	return b
+}
+
+// NoWait, kilitli bir satırla karşılaşıldığında beklemek yerine hemen hata döndürür.
+// LockForUpdate veya SharedLock'tan sonra çağrılmalıdır.
+func (b *Builder) NoWait() *Builder {
+	if b.lock == nil {
+		b.err = ErrLockModifierWithoutLock
+		return b
+	}
+	b.lock.Wait = dialect.LockNoWait
+	return b
+}
+
+// Of, kilidi yalnızca belirtilen tabloların satırlarıyla sınırlar (FOR UPDATE OF ...).
+// JOIN içeren sorgularda gereksiz kilitlenmeyi önler.
+func (b *Builder) Of(tables ...string) *Builder {
+	if b.lock == nil {
+		b.err = ErrLockModifierWithoutLock
+		return b
+	}
+	b.lock.Of = append(b.lock.Of, tables...)
+	return b
+}
+
 // ToSQL, sorguyu SQL string ve bindinglerle döndürür.
 func (b *Builder) ToSQL() (string, []any, error) {
 	return b.ToSelectSQL()
@@ -519,7 +716,7 @@ func (b *Builder) ToInsertSQL(data map[string]any) (string, []any, error) {
 	if b.grammar == nil {
 		return "", nil, ErrNoExecutor
 	}
-	return b.grammar.CompileInsert(b, data)
+	return b.grammar.CompileInsert(b, b.prefixData(data))
 }
 
 // ToUpdateSQL, UPDATE sorgusunu derler.
@@ -530,7 +727,7 @@ func (b *Builder) ToUpdateSQL(data map[string]any) (string, []any, error) {
 	if b.grammar == nil {
 		return "", nil, ErrNoExecutor
 	}
-	return b.grammar.CompileUpdate(b, data)
+	return b.grammar.CompileUpdate(b, b.prefixData(data))
 }
 
 // ToDeleteSQL, DELETE sorgusunu derler.
@@ -550,12 +747,23 @@ func (b *Builder) Clone() *Builder {
 		executor:   b.executor,
 		grammar:    b.grammar,
 		scanner:    b.scanner,
+		tx:         b.tx,
+		logger:     b.logger,
+		debug:      b.debug,
+		redact:     b.redact,
+		prefix:     b.prefix,
+		unprefixed: b.unprefixed,
 		table:      b.table,
-		tableAlias: b.tableAlias,
-		distinct:   b.distinct,
-		limit:      b.limit,
-		offset:     b.offset,
-		err:        b.err,
+
+		interceptors: b.interceptors,
+		cluster:      b.cluster,
+		usePrimary:   b.usePrimary,
+		retry:        b.retry,
+		tableAlias:   b.tableAlias,
+		distinct:     b.distinct,
+		limit:        b.limit,
+		offset:       b.offset,
+		err:          b.err,
 	}
 
 	clone.columns = make([]string, len(b.columns))
@@ -576,6 +784,16 @@ func (b *Builder) Clone() *Builder {
 	clone.having = make([]dialect.WhereClause, len(b.having))
 	copy(clone.having, b.having)
 
+	if b.lock != nil {
+		lock := *b.lock
+		lock.Of = append([]string(nil), b.lock.Of...)
+		clone.lock = &lock
+	}
+
+	if b.returning != nil {
+		clone.returning = append([]string(nil), b.returning...)
+	}
+
 	return clone
 }
 
@@ -592,6 +810,8 @@ func (b *Builder) Reset() *Builder {
 	b.having = make([]dialect.WhereClause, 0)
 	b.limit = nil
 	b.offset = nil
+	b.lock = nil
+	b.returning = nil
 	b.err = nil
 	return b
 }
@@ -614,9 +834,9 @@ func (b *Builder) Unless(condition bool, fn func(*Builder)) *Builder {
 	return b.When(!condition, fn)
 }
 
-// GetTable, tablo adını döndürür.
+// GetTable, tablo adını (varsa önekiyle) döndürür.
 func (b *Builder) GetTable() string {
-	return b.table
+	return b.prefixTable(b.table)
 }
 
 // GetTableAlias, tablo aliasını döndürür.
@@ -626,7 +846,10 @@ func (b *Builder) GetTableAlias() string {
 
 // GetColumns, seçilen kolonları döndürür.
 func (b *Builder) GetColumns() []string {
-	return b.columns
+	if b.prefix == "" {
+		return b.columns
+	}
+	return b.prefixColumns(b.columns, b.aliases())
 }
 
 // IsDistinct, DISTINCT kullanılıp kullanılmadığını döndürür.
@@ -636,27 +859,59 @@ func (b *Builder) IsDistinct() bool {
 
 // GetWheres, WHERE koşullarını döndürür.
 func (b *Builder) GetWheres() []dialect.WhereClause {
-	return b.wheres
+	if b.prefix == "" {
+		return b.wheres
+	}
+	return b.prefixWheres(b.wheres, b.aliases())
 }
 
 // GetOrders, ORDER BY koşullarını döndürür.
 func (b *Builder) GetOrders() []dialect.OrderClause {
-	return b.orders
+	if b.prefix == "" {
+		return b.orders
+	}
+	aliases := b.aliases()
+	orders := make([]dialect.OrderClause, len(b.orders))
+	for i, o := range b.orders {
+		o.Column = b.prefixColumn(o.Column, aliases)
+		o.FullText = b.prefixFullText(o.FullText, aliases)
+		orders[i] = o
+	}
+	return orders
 }
 
 // GetJoins, JOIN koşullarını döndürür.
 func (b *Builder) GetJoins() []dialect.JoinClause {
-	return b.joins
+	if b.prefix == "" {
+		return b.joins
+	}
+	aliases := b.aliases()
+	joins := make([]dialect.JoinClause, len(b.joins))
+	for i, j := range b.joins {
+		if j.Subquery == nil {
+			j.Table = b.prefixTable(j.Table)
+		}
+		j.First = b.prefixColumn(j.First, aliases)
+		j.Second = b.prefixColumn(j.Second, aliases)
+		joins[i] = j
+	}
+	return joins
 }
 
 // GetGroupBy, GROUP BY kolonlarını döndürür.
 func (b *Builder) GetGroupBy() []string {
-	return b.groupBy
+	if b.prefix == "" {
+		return b.groupBy
+	}
+	return b.prefixColumns(b.groupBy, b.aliases())
 }
 
 // GetHaving, HAVING koşullarını döndürür.
 func (b *Builder) GetHaving() []dialect.WhereClause {
-	return b.having
+	if b.prefix == "" {
+		return b.having
+	}
+	return b.prefixWheres(b.having, b.aliases())
 }
 
 // GetLimit, LIMIT değerini döndürür.
@@ -669,24 +924,53 @@ func (b *Builder) GetOffset() *int {
 	return b.offset
 }
 
+// GetLock, satır kilidi ifadesini döndürür.
+func (b *Builder) GetLock() *dialect.LockClause {
+	if b.prefix == "" || b.lock == nil || len(b.lock.Of) == 0 {
+		return b.lock
+	}
+	aliases := b.aliases()
+	lock := *b.lock
+	lock.Of = make([]string, len(b.lock.Of))
+	for i, t := range b.lock.Of {
+		if aliases[t] {
+			lock.Of[i] = t
+			continue
+		}
+		lock.Of[i] = b.prefixTable(t)
+	}
+	return &lock
+}
+
+// GetReturning, RETURNING kolonlarını döndürür.
+func (b *Builder) GetReturning() []string {
+	if b.prefix == "" {
+		return b.returning
+	}
+	return b.prefixColumns(b.returning, b.aliases())
+}
+
 // GetContext, sorguyu çalıştırır ve sonuçları dest içine tarar.
 func (b *Builder) GetContext(ctx context.Context, dest any) error {
 	if b.executor == nil {
 		return ErrNoExecutor
 	}
+	if b.lock != nil && b.tx == nil {
+		return ErrLockOutsideTransaction
+	}
 
-	sqlStr, args, err := b.ToSelectSQL()
+	sqlStr, args, err := b.sensitive().ToSelectSQL()
 	if err != nil {
 		return err
 	}
 
-	rows, err := b.executor.QueryContext(ctx, sqlStr, args...)
+	rows, err := b.queryContext(ctx, "select", sqlStr, args)
 	if err != nil {
 		return NewQueryError("select", b.table, sqlStr, err)
 	}
 	defer rows.Close()
 
-	return b.scanner.ScanRows(rows, dest)
+	return classifyError(b.grammar, b.scanner.ScanRows(rows, dest))
 }
 
 // Get, GetContext’in context.Background() versiyonudur.
@@ -701,14 +985,20 @@ func (b *Builder) FirstContext(ctx context.Context, dest any) error {
 	if b.executor == nil {
 		return ErrNoExecutor
 	}
+	if b.lock != nil && b.tx == nil {
+		return ErrLockOutsideTransaction
+	}
 
-	sqlStr, args, err := b.ToSelectSQL()
+	sqlStr, args, err := b.sensitive().ToSelectSQL()
 	if err != nil {
 		return err
 	}
 
-	row := b.executor.QueryRowContext(ctx, sqlStr, args...)
-	return b.scanner.ScanRow(row, dest)
+	row, err := b.queryRowContext(ctx, "select", sqlStr, args)
+	if err != nil {
+		return NewQueryError("select", b.table, sqlStr, err)
+	}
+	return classifyError(b.grammar, b.scanner.ScanRow(row, dest))
 }
 
 // First, FirstContext’in context.Background() versiyonudur.
@@ -722,12 +1012,12 @@ func (b *Builder) InsertContext(ctx context.Context, data map[string]any) (*Quer
 		return nil, ErrNoExecutor
 	}
 
-	sqlStr, args, err := b.ToInsertSQL(data)
+	sqlStr, args, err := b.ToInsertSQL(b.sensitiveData(data))
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := b.executor.ExecContext(ctx, sqlStr, args...)
+	result, err := b.execContext(ctx, "insert", sqlStr, args)
 	if err != nil {
 		return nil, NewQueryError("insert", b.table, sqlStr, err)
 	}
@@ -746,12 +1036,12 @@ func (b *Builder) UpdateContext(ctx context.Context, data map[string]any) (*Quer
 		return nil, ErrNoExecutor
 	}
 
-	sqlStr, args, err := b.ToUpdateSQL(data)
+	sqlStr, args, err := b.sensitive().ToUpdateSQL(b.sensitiveData(data))
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := b.executor.ExecContext(ctx, sqlStr, args...)
+	result, err := b.execContext(ctx, "update", sqlStr, args)
 	if err != nil {
 		return nil, NewQueryError("update", b.table, sqlStr, err)
 	}
@@ -764,18 +1054,51 @@ func (b *Builder) Update(data map[string]any) (*QueryResult, error) {
 	return b.UpdateContext(context.Background(), data)
 }
 
+// IncrementContext, kolonu verilen miktar kadar artırır (SET col = col + ?).
+// extra ile aynı sorguda başka kolonlar da güncellenebilir.
+//
+// Örnek:
+//
+//	db.Table("posts").Where("id", "=", 1).IncrementContext(ctx, "views", 1, nil)
+func (b *Builder) IncrementContext(ctx context.Context, column string, amount any, extra map[string]any) (*QueryResult, error) {
+	data, err := incrementData(column, "+", amount, extra)
+	if err != nil {
+		return nil, err
+	}
+	return b.UpdateContext(ctx, data)
+}
+
+// Increment, IncrementContext’in context.Background() versiyonudur.
+func (b *Builder) Increment(column string, amount any, extra map[string]any) (*QueryResult, error) {
+	return b.IncrementContext(context.Background(), column, amount, extra)
+}
+
+// DecrementContext, kolonu verilen miktar kadar azaltır (SET col = col - ?).
+func (b *Builder) DecrementContext(ctx context.Context, column string, amount any, extra map[string]any) (*QueryResult, error) {
+	data, err := incrementData(column, "-", amount, extra)
+	if err != nil {
+		return nil, err
+	}
+	return b.UpdateContext(ctx, data)
+}
+
+// Decrement, DecrementContext’in context.Background() versiyonudur.
+func (b *Builder) Decrement(column string, amount any, extra map[string]any) (*QueryResult, error) {
+	return b.DecrementContext(context.Background(), column, amount, extra)
+}
+
 // DeleteContext, DELETE sorgusu çalıştırır.
 func (b *Builder) DeleteContext(ctx context.Context) (*QueryResult, error) {
 	if b.executor == nil {
 		return nil, ErrNoExecutor
 	}
 
-	sqlStr, args, err := b.ToDeleteSQL()
+	sqlStr, args, err := b.sensitive().ToDeleteSQL()
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := b.executor.ExecContext(ctx, sqlStr, args...)
+	result, err := b.execContext(ctx, "delete", sqlStr, args)
 	if err != nil {
 		return nil, NewQueryError("delete", b.table, sqlStr, err)
 	}
@@ -788,24 +1111,236 @@ func (b *Builder) Delete() (*QueryResult, error) {
 	return b.DeleteContext(context.Background())
 }
 
+// InsertReturningContext, INSERT çalıştırır ve Returning ile seçilen kolonları
+// dest içine tarar. dest bir struct veya struct slice pointer'ı olmalıdır.
+//
+// RETURNING destekleyen gramerlerde (PostgreSQL, SQLite 3.35+, MariaDB
+// 10.5+) tek sorgu yeterlidir.
+// MySQL'de tek satırlık ekleme emüle edilir: INSERT sonrasında satır, birincil
+// anahtar (data içinde verilmişse o değer, yoksa LastInsertId) ile geri okunur.
+// Satır yazıldıktan sonra geri okunamazsa ErrReturningAfterWrite döner; bu
+// durumda INSERT tekrarlanmamalıdır.
+//
+// Örnek:
+//
+//	var user User
+//	err := db.Table("users").Returning("id", "uuid", "created_at").
+//	    InsertReturningContext(ctx, map[string]any{"name": "Ahmet"}, &user)
+func (b *Builder) InsertReturningContext(ctx context.Context, data map[string]any, dest any) error {
+	if b.executor == nil || b.grammar == nil {
+		return ErrNoExecutor
+	}
+
+	q := b.returningBuilder()
+	if !b.grammar.SupportsReturning() {
+		return q.emulateInsertReturning(ctx, data, dest)
+	}
+
+	sqlStr, args, err := q.ToInsertSQL(q.sensitiveData(data))
+	if err != nil {
+		return err
+	}
+
+	return q.queryReturning(ctx, "insert", sqlStr, args, dest)
+}
+
+// InsertReturning, InsertReturningContext’in context.Background() versiyonudur.
+func (b *Builder) InsertReturning(data map[string]any, dest any) error {
+	return b.InsertReturningContext(context.Background(), data, dest)
+}
+
+// UpdateReturningContext, UPDATE çalıştırır ve güncellenen satırları dest içine tarar.
+// Gramer UPDATE ... RETURNING desteklemiyorsa (MySQL, MariaDB)
+// dialect.ErrReturningNotSupported döner.
+func (b *Builder) UpdateReturningContext(ctx context.Context, data map[string]any, dest any) error {
+	if b.executor == nil {
+		return ErrNoExecutor
+	}
+
+	q := b.returningBuilder()
+	sqlStr, args, err := q.sensitive().ToUpdateSQL(q.sensitiveData(data))
+	if err != nil {
+		return err
+	}
+
+	return q.queryReturning(ctx, "update", sqlStr, args, dest)
+}
+
+// UpdateReturning, UpdateReturningContext’in context.Background() versiyonudur.
+func (b *Builder) UpdateReturning(data map[string]any, dest any) error {
+	return b.UpdateReturningContext(context.Background(), data, dest)
+}
+
+// DeleteReturningContext, DELETE çalıştırır ve silinen satırları dest içine tarar.
+// Gramer DELETE ... RETURNING desteklemiyorsa (MySQL) dialect.ErrReturningNotSupported döner.
+func (b *Builder) DeleteReturningContext(ctx context.Context, dest any) error {
+	if b.executor == nil {
+		return ErrNoExecutor
+	}
+
+	q := b.returningBuilder()
+	sqlStr, args, err := q.sensitive().ToDeleteSQL()
+	if err != nil {
+		return err
+	}
+
+	return q.queryReturning(ctx, "delete", sqlStr, args, dest)
+}
+
+// DeleteReturning, DeleteReturningContext’in context.Background() versiyonudur.
+func (b *Builder) DeleteReturning(dest any) error {
+	return b.DeleteReturningContext(context.Background(), dest)
+}
+
+// returningBuilder, RETURNING kolonları boşsa "*" ile dolduran bir kopya döndürür.
+func (b *Builder) returningBuilder() *Builder {
+	q := b.Clone()
+	if len(q.returning) == 0 {
+		q.returning = []string{"*"}
+	}
+	return q
+}
+
+// queryReturning, RETURNING içeren ifadeyi çalıştırır ve dönen satırları tarar.
+func (b *Builder) queryReturning(ctx context.Context, op, sqlStr string, args []any, dest any) error {
+	rows, err := b.queryContext(ctx, op, sqlStr, args)
+	if err != nil {
+		return NewQueryError(op, b.table, sqlStr, err)
+	}
+
+	return classifyError(b.grammar, b.scanReturning(rows, dest))
+}
+
+// scanReturning, dönen satırları kolon adlarına göre dest içine tarar.
+// dest tek bir struct ise ilk satır kullanılır; satır yoksa ErrNoRows döner.
+func (b *Builder) scanReturning(rows *sql.Rows, dest any) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		rows.Close()
+		return ErrNotAPointer
+	}
+	if v.Elem().Kind() == reflect.Slice {
+		return b.scanner.ScanRows(rows, dest)
+	}
+
+	// Tek satır: ScanRows'un kolon adı eşlemesinden yararlanmak için geçici slice
+	tmp := reflect.New(reflect.SliceOf(v.Elem().Type()))
+	if err := b.scanner.ScanRows(rows, tmp.Interface()); err != nil {
+		return err
+	}
+	if tmp.Elem().Len() == 0 {
+		return ErrNoRows
+	}
+	v.Elem().Set(tmp.Elem().Index(0))
+	return nil
+}
+
+// emulateInsertReturning, RETURNING desteklemeyen MySQL için tek satırlık
+// INSERT ... RETURNING davranışını INSERT + SELECT ile taklit eder.
+//
+// Önceden bilinebilen engeller (geçersiz dest, ifade olarak verilen birincil
+// anahtar, derlenemeyen SELECT) INSERT'ten önce döner. INSERT çalıştıktan
+// sonraki hatalar ErrReturningAfterWrite ile sarılır: satır yazılmıştır ve
+// işlemin tekrarlanması kopya satır üretir.
+func (b *Builder) emulateInsertReturning(ctx context.Context, data map[string]any, dest any) error {
+	if v := reflect.ValueOf(dest); v.Kind() != reflect.Ptr || v.IsNil() {
+		return ErrNotAPointer
+	}
+
+	// Uygulamanın ürettiği anahtar (örn. UUID) varsa onu, yoksa AUTO_INCREMENT değerini kullan
+	pk := b.primaryKeyFor(dest)
+	id, hasID := data[pk]
+	if _, isExpr := id.(dialect.Expression); isExpr {
+		return dialect.ErrReturningNotSupported // SQL ifadesinin ürettiği değer geri okunamaz
+	}
+	if _, _, err := b.returningSelect(pk, id).ToSelectSQL(); err != nil {
+		return err
+	}
+
+	plain := b.Clone()
+	plain.returning = nil
+
+	result, err := plain.InsertContext(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	if !hasID {
+		lastID, err := result.LastInsertID()
+		if err != nil || lastID == 0 {
+			return NewQueryError("insert", b.table, "", fmt.Errorf("%w: no primary key value and no AUTO_INCREMENT id", ErrReturningAfterWrite))
+		}
+		id = lastID
+	}
+
+	sel := b.returningSelect(pk, id)
+	sqlStr, args, err := sel.ToSelectSQL()
+	if err == nil {
+		err = sel.queryReturning(ctx, "select", sqlStr, args, dest)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrReturningAfterWrite, err)
+	}
+	return nil
+}
+
+// returningSelect, eklenen satırı birincil anahtarıyla geri okuyan sorguyu kurar.
+func (b *Builder) returningSelect(pk string, id any) *Builder {
+	sel := NewBuilder(b.executor, b.grammar, b.scanner).
+		TableAs(b.table, b.tableAlias).
+		Select(b.returning...).
+		Where(pk, "=", id).
+		Limit(1)
+	sel.tx = b.tx
+	sel.logger, sel.debug, sel.redact = b.logger, b.debug, b.redact
+	sel.prefix, sel.unprefixed = b.prefix, b.unprefixed
+	sel.interceptors = b.interceptors // cluster kopyalanmaz: yeni satır primary'den okunmalı
+	return sel
+}
+
+// primaryKeyFor, dest tipinden birincil anahtar kolonunu çözer.
+// Scanner bu bilgiyi sağlamıyorsa "id" varsayılır.
+func (b *Builder) primaryKeyFor(dest any) string {
+	pk, ok := b.scanner.(interface{ GetPrimaryKey(dest any) string })
+	if !ok {
+		return "id"
+	}
+
+	t := reflect.TypeOf(dest)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "id"
+	}
+
+	if name := pk.GetPrimaryKey(reflect.New(t).Interface()); name != "" {
+		return name
+	}
+	return "id"
+}
+
 // CountContext, sorgu için toplam satır sayısını döndürür.
 func (b *Builder) CountContext(ctx context.Context) (int64, error) {
 	if b.executor == nil {
 		return 0, ErrNoExecutor
 	}
 
-	sqlStr, args, err := b.grammar.CompileCount(b, "")
+	sqlStr, args, err := b.grammar.CompileCount(b.sensitive(), "")
 	if err != nil {
 		return 0, err
 	}
 
 	var count int64
-	row := b.executor.QueryRowContext(ctx, sqlStr, args...)
+	row, err := b.queryRowContext(ctx, "count", sqlStr, args)
+	if err != nil {
+		return 0, NewQueryError("count", b.table, sqlStr, err)
+	}
 	if err := row.Scan(&count); err != nil {
 		if err == sql.ErrNoRows {
 			return 0, nil
 		}
-		return 0, NewQueryError("count", b.table, sqlStr, err)
+		return 0, NewQueryError("count", b.table, sqlStr, classifyError(b.grammar, err))
 	}
 
 	return count, nil
@@ -822,16 +1357,19 @@ func (b *Builder) ExistsContext(ctx context.Context) (bool, error) {
 		return false, ErrNoExecutor
 	}
 
-	sqlStr, args, err := b.grammar.CompileExists(b)
+	sqlStr, args, err := b.grammar.CompileExists(b.sensitive())
 	if err != nil {
 		return false, err
 	}
 
 	var exists bool
-	row := b.executor.QueryRowContext(ctx, sqlStr, args...)
-	if err := row.Scan(&exists); err != nil {
+	row, err := b.queryRowContext(ctx, "exists", sqlStr, args)
+	if err != nil {
 		return false, NewQueryError("exists", b.table, sqlStr, err)
 	}
+	if err := row.Scan(&exists); err != nil {
+		return false, NewQueryError("exists", b.table, sqlStr, classifyError(b.grammar, err))
+	}
 
 	return exists, nil
 }
@@ -851,3 +1389,126 @@ func (b *Builder) DoesntExistContext(ctx context.Context) (bool, error) {
 func (b *Builder) DoesntExist() (bool, error) {
 	return b.DoesntExistContext(context.Background())
 }
+
+// execContext, ifadeyi interceptor zincirinden geçirerek çalıştırır.
+// Sensitive ile işaretlenmiş değerler sürücüye ham hâliyle, loga maskelenmiş olarak gider.
+// Transaction dışındaki ifadeler Retry politikasına göre yeniden denenir.
+func (b *Builder) execContext(ctx context.Context, op, query string, args []any) (sql.Result, error) {
+	if err := b.checkWritable(op); err != nil {
+		return nil, err
+	}
+
+	var out QueryOutcome
+	run := func() (err error) {
+		out, err = runQuery(ctx, b.executor, b.grammar, b.interceptors, b.logger, b.debug, b.queryInfo(op, MethodExec, query, args))
+		return err
+	}
+
+	if b.tx != nil {
+		// Transaction içinde ifade tekrarı güvenli değildir; tekrar transaction seviyesinde yapılır
+		return out.Result, run()
+	}
+	err := b.retry.do(ctx, b.grammar, run)
+	return out.Result, err
+}
+
+// queryContext, satır döndüren ifadeyi interceptor zincirinden geçirerek çalıştırır.
+func (b *Builder) queryContext(ctx context.Context, op, query string, args []any) (*sql.Rows, error) {
+	if err := b.checkWritable(op); err != nil {
+		return nil, err
+	}
+	out, err := runQuery(ctx, b.readerFor(op), b.grammar, b.interceptors, b.logger, b.debug, b.queryInfo(op, MethodQuery, query, args))
+	return out.Rows, err
+}
+
+// queryRowContext, tek satırlık ifadeyi interceptor zincirinden geçirerek çalıştırır.
+// Sürücü hataları Row.Scan sırasında; interceptor'ın kısa devre hataları burada döner.
+// Scan hataları çağıran tarafta classifyError ile sınıflandırılmalıdır.
+func (b *Builder) queryRowContext(ctx context.Context, op, query string, args []any) (*sql.Row, error) {
+	if err := b.checkWritable(op); err != nil {
+		return nil, err
+	}
+	out, err := runQuery(ctx, b.readerFor(op), b.grammar, b.interceptors, b.logger, b.debug, b.queryInfo(op, MethodQueryRow, query, args))
+	return out.Row, err
+}
+
+// checkWritable, salt okunur transaction içinde yazma işlemlerini SQL
+// sunucuya gitmeden reddeder.
+func (b *Builder) checkWritable(op string) error {
+	if b.tx != nil && b.tx.ReadOnly() && !isReadOperation(op) {
+		return ErrReadOnlyTransaction
+	}
+	return nil
+}
+
+// queryInfo, interceptor'lara verilecek sorgu tanımını oluşturur.
+func (b *Builder) queryInfo(op string, method QueryMethod, query string, args []any) QueryInfo {
+	return QueryInfo{
+		Operation:     op,
+		Method:        method,
+		Table:         b.GetTable(),
+		Dialect:       b.grammar.Name(),
+		SQL:           query,
+		Args:          args,
+		InTransaction: b.tx != nil,
+		Tx:            b.tx,
+	}
+}
+
+// redacting, bağlamaları dışarıya raporlayan bir logger veya interceptor
+// varsa ve maskeleme kuralı tanımlıysa true döner.
+func (b *Builder) redacting() bool {
+	return len(b.redact) > 0 && (isLogging(b.logger) || len(b.interceptors) > 0)
+}
+
+// sensitive, loglama veya interceptor varsa hassas kolonlara ait WHERE/HAVING
+// değerlerini Sensitive ile işaretlenmiş bir kopya döndürür; aksi halde builder'ın kendisini.
+func (b *Builder) sensitive() *Builder {
+	if !b.redacting() {
+		return b
+	}
+	q := b.Clone()
+	q.wheres = redactWheres(q.wheres, q.redact)
+	q.having = redactWheres(q.having, q.redact)
+	return q
+}
+
+// sensitiveData, redacting durumunda hassas kolonların değerlerini Sensitive ile
+// işaretlenmiş yeni bir veri haritası döndürür. Çağıranın haritası değiştirilmez.
+func (b *Builder) sensitiveData(data map[string]any) map[string]any {
+	if !b.redacting() {
+		return data
+	}
+	return redactData(data, b.redact)
+}
+
+// newFullTextClause, WhereFullText ve OrderByRelevance için ortak arama ifadesini oluşturur.
+// Kolon listesi kopyalanır; böylece çağıranın slice'ı sonradan değiştirmesi sorguyu etkilemez.
+func newFullTextClause(columns []string, query string, opts []dialect.FullTextOptions) *dialect.FullTextClause {
+	ft := &dialect.FullTextClause{
+		Columns: append([]string(nil), columns...),
+		Query:   query,
+	}
+	if len(opts) > 0 {
+		ft.Options = opts[0]
+	}
+	return ft
+}
+
+// incrementData, Increment/Decrement için UPDATE veri haritasını hazırlar.
+// Miktar sayısal olmalıdır; aksi halde string birleştirme gibi sürpriz
+// davranışların önüne geçmek için ValidationError döner.
+func incrementData(column, operator string, amount any, extra map[string]any) (map[string]any, error) {
+	switch amount.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+	default:
+		return nil, NewValidationError("value", fmt.Sprint(amount), "increment amount must be numeric")
+	}
+
+	data := make(map[string]any, len(extra)+1)
+	for k, v := range extra {
+		data[k] = v
+	}
+	data[column] = NewExpr(column, operator, amount)
+	return data, nil
+}
@@ -0,0 +1,249 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+//  Read/Write Ayrımı — Primary ve Replica Yönlendirmesi
+//
+//  Cluster, yazmaların gittiği tek bir primary ile okumaların dağıtıldığı
+//  replica'ları bir arada tutar. WithCluster ile DB'ye bağlandığında:
+//
+//   • SELECT, COUNT, EXISTS ve EXPLAIN sorguları sağlıklı bir replica'ya,
+//   • INSERT, UPDATE, DELETE ve RETURNING içeren yazmalar primary'ye,
+//   • Transaction içindeki her şey (okumalar dahil) primary'ye gider.
+//
+//  Kilitli okumalar (LockForUpdate, SharedLock) ve UsePrimary ile işaretlenen
+//  builder'lar da primary'de çalışır; replikasyon gecikmesinin kabul edilemediği
+//  "yazdığını oku" senaryoları için UsePrimary kullanılmalıdır.
+//
+//  Replica seçimi takılabilir bir Balancer ile yapılır (RoundRobin, Random,
+//  LeastConnections). StartHealthCheck, replica'ları periyodik olarak Ping'ler;
+//  yanıt vermeyenler rotasyondan çıkarılır, iyileşenler geri alınır. Hiç
+//  sağlıklı replica yoksa okumalar primary'ye düşer.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Balancer, okuma sorgusu için replica seçer. Pick'e yalnızca sağlıklı
+// replica'lar verilir ve liste hiçbir zaman boş değildir. Uygulamalar
+// eşzamanlı çağrılara karşı güvenli olmalıdır.
+type Balancer interface {
+	Pick(replicas []*sql.DB) *sql.DB
+}
+
+// roundRobin, replica'ları sırayla seçer.
+type roundRobin struct {
+	next atomic.Uint64
+}
+
+// RoundRobin, replica'ları sırayla dolaşan Balancer'ı döndürür. Varsayılan budur.
+func RoundRobin() Balancer {
+	return &roundRobin{}
+}
+
+func (r *roundRobin) Pick(replicas []*sql.DB) *sql.DB {
+	n := r.next.Add(1) - 1
+	return replicas[n%uint64(len(replicas))]
+}
+
+// random, replica'ları rastgele seçer.
+type random struct{}
+
+// Random, her okuma için rastgele bir replica seçen Balancer'ı döndürür.
+func Random() Balancer {
+	return random{}
+}
+
+func (random) Pick(replicas []*sql.DB) *sql.DB {
+	return replicas[rand.IntN(len(replicas))]
+}
+
+// leastConnections, kullanımda en az bağlantısı olan replica'yı seçer.
+type leastConnections struct{}
+
+// LeastConnections, sql.DBStats.InUse değeri en düşük replica'yı seçen
+// Balancer'ı döndürür. Eşitlikte listedeki ilk replica seçilir.
+func LeastConnections() Balancer {
+	return leastConnections{}
+}
+
+func (leastConnections) Pick(replicas []*sql.DB) *sql.DB {
+	best, bestInUse := replicas[0], replicas[0].Stats().InUse
+	for _, r := range replicas[1:] {
+		if inUse := r.Stats().InUse; inUse < bestInUse {
+			best, bestInUse = r, inUse
+		}
+	}
+	return best
+}
+
+// Cluster, bir primary ve sıfır veya daha fazla replica bağlantı havuzunu yönetir.
+type Cluster struct {
+	primary  *sql.DB
+	replicas []*sql.DB
+	balancer Balancer
+
+	healthy atomic.Pointer[[]*sql.DB] // Rotasyondaki replica'lar
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewCluster, yeni bir Cluster oluşturur. Başlangıçta tüm replica'lar
+// sağlıklı kabul edilir ve RoundRobin kullanılır.
+//
+// Örnek:
+//
+//	cluster := fluentsql.NewCluster(primary, replica1, replica2).
+//	    WithBalancer(fluentsql.LeastConnections())
+//	stop := cluster.StartHealthCheck(5 * time.Second)
+//	defer stop()
+//
+//	db := fluentsql.NewDB(primary, fluentsql.WithCluster(cluster))
+func NewCluster(primary *sql.DB, replicas ...*sql.DB) *Cluster {
+	c := &Cluster{
+		primary:  primary,
+		replicas: append([]*sql.DB(nil), replicas...),
+		balancer: RoundRobin(),
+		stop:     make(chan struct{}),
+	}
+	healthy := append([]*sql.DB(nil), replicas...)
+	c.healthy.Store(&healthy)
+	return c
+}
+
+// WithBalancer, replica seçim stratejisini değiştirir. Nil verilirse
+// değişiklik yapılmaz. Okuma trafiği başlamadan önce çağrılmalıdır.
+func (c *Cluster) WithBalancer(b Balancer) *Cluster {
+	if b != nil {
+		c.balancer = b
+	}
+	return c
+}
+
+// Primary, yazmaların gittiği bağlantı havuzunu döndürür.
+func (c *Cluster) Primary() *sql.DB {
+	return c.primary
+}
+
+// Replicas, tanımlı tüm replica'ları (sağlık durumundan bağımsız) döndürür.
+func (c *Cluster) Replicas() []*sql.DB {
+	return append([]*sql.DB(nil), c.replicas...)
+}
+
+// Healthy, şu anda rotasyonda olan replica'ları döndürür.
+func (c *Cluster) Healthy() []*sql.DB {
+	return append([]*sql.DB(nil), *c.healthy.Load()...)
+}
+
+// Reader, bir okuma sorgusu için bağlantı havuzu seçer. Sağlıklı replica
+// yoksa primary döner.
+func (c *Cluster) Reader() *sql.DB {
+	healthy := *c.healthy.Load()
+	if len(healthy) == 0 {
+		return c.primary
+	}
+	return c.balancer.Pick(healthy)
+}
+
+// CheckHealth, tüm replica'ları bir kez Ping'ler ve rotasyonu günceller.
+// Ping'i başarısız olan replica'ların hataları birleştirilerek döndürülür.
+func (c *Cluster) CheckHealth(ctx context.Context) error {
+	var errs []error
+	healthy := make([]*sql.DB, 0, len(c.replicas))
+	for _, r := range c.replicas {
+		if err := r.PingContext(ctx); err != nil {
+			errs = append(errs, WrapError("replica ping", err))
+			continue
+		}
+		healthy = append(healthy, r)
+	}
+	c.healthy.Store(&healthy)
+	return errors.Join(errs...)
+}
+
+// StartHealthCheck, replica'ları interval aralıklarla arka planda Ping'ler.
+// Her tur en fazla interval kadar sürebilir. Dönen fonksiyon kontrolleri
+// durdurur; Cluster.Close da aynı işi yapar. Durdurulan kontroller aynı
+// Cluster üzerinde yeniden başlatılamaz.
+func (c *Cluster) StartHealthCheck(interval time.Duration) (stop func()) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				_ = c.CheckHealth(ctx)
+				cancel()
+			}
+		}
+	}()
+	return c.stopHealthCheck
+}
+
+// stopHealthCheck, arka plan sağlık kontrollerini durdurur ve bitmelerini bekler.
+func (c *Cluster) stopHealthCheck() {
+	c.stopOnce.Do(func() { close(c.stop) })
+	c.wg.Wait()
+}
+
+// Close, sağlık kontrollerini durdurur ve primary ile tüm replica
+// bağlantı havuzlarını kapatır.
+func (c *Cluster) Close() error {
+	c.stopHealthCheck()
+
+	errs := []error{c.primary.Close()}
+	for _, r := range c.replicas {
+		errs = append(errs, r.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// isReadOperation, replica'ya yönlendirilebilecek Builder işlemlerini belirler.
+func isReadOperation(op string) bool {
+	switch op {
+	case "select", "count", "exists", "explain":
+		return true
+	}
+	return false
+}
+
+// readerFor, işlem için kullanılacak executor'ı seçer. Okumalar; transaction
+// dışında, kilitsiz ve UsePrimary ile işaretlenmemişse replica'ya gider.
+func (b *Builder) readerFor(op string) QueryExecutor {
+	if b.cluster == nil || b.tx != nil || b.usePrimary || b.lock != nil || !isReadOperation(op) {
+		return b.executor
+	}
+	return b.cluster.Reader()
+}
+
+// UsePrimary, bu builder'ın okumalarını da primary'ye yönlendirir. Az önce
+// yazılan verinin replikasyon gecikmesine takılmadan okunması gerektiğinde
+// kullanılır. Cluster tanımlı değilse etkisizdir.
+//
+// Örnek:
+//
+//	db.Table("orders").InsertContext(ctx, order)
+//	db.Table("orders").UsePrimary().Where("id", "=", id).FirstContext(ctx, &o)
+func (b *Builder) UsePrimary() *Builder {
+	b.usePrimary = true
+	return b
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/migrate"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+// migrationName, "migrate make" ile verilebilecek adların biçimidir.
+var migrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// connect, ayarlarla veritabanına bağlanır.
+func connect(opts *options) (*fluentsql.DB, error) {
+	if opts.config.Driver == "sqlite" && opts.config.Database == "" {
+		return nil, fmt.Errorf("%w: sqlite requires -database (file path)", errUsage)
+	}
+	return fluentsql.ConnectWithConfig(opts.config)
+}
+
+// ---------------------------------------------------------------------------
+// migrate
+// ---------------------------------------------------------------------------
+
+// migrator, migration dizinini okuyup bir Migrator kurar.
+func migrator(db *fluentsql.DB, opts *options) (*migrate.Migrator, error) {
+	grammar, err := schema.GrammarFor(opts.config.Driver)
+	if err != nil {
+		return nil, err
+	}
+	migrateOpts := []migrate.Option{migrate.WithGrammar(grammar)}
+	if opts.migrationTable != "" {
+		migrateOpts = append(migrateOpts, migrate.WithTable(opts.migrationTable))
+	}
+	m := migrate.New(db, migrateOpts...)
+
+	migrations, err := migrate.FromFS(os.DirFS(opts.migrationsDir), ".")
+	if err != nil {
+		return nil, fmt.Errorf("reading migrations: %w", err)
+	}
+	if err := m.Add(migrations...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func migrateUp(ctx context.Context, db *fluentsql.DB, opts *options, out io.Writer) error {
+	m, err := migrator(db, opts)
+	if err != nil {
+		return err
+	}
+	ran, err := m.Up(ctx)
+	for _, name := range ran {
+		fmt.Fprintf(out, "Migrated:    %s\n", name)
+	}
+	if err == nil && len(ran) == 0 {
+		fmt.Fprintln(out, "Nothing to migrate.")
+	}
+	return err
+}
+
+func migrateDown(ctx context.Context, db *fluentsql.DB, opts *options, out io.Writer) error {
+	m, err := migrator(db, opts)
+	if err != nil {
+		return err
+	}
+	var ran []string
+	if opts.steps > 0 {
+		ran, err = m.Rollback(ctx, opts.steps)
+	} else {
+		ran, err = m.Down(ctx)
+	}
+	for _, name := range ran {
+		fmt.Fprintf(out, "Rolled back: %s\n", name)
+	}
+	if err == nil && len(ran) == 0 {
+		fmt.Fprintln(out, "Nothing to roll back.")
+	}
+	return err
+}
+
+func migrateStatus(ctx context.Context, db *fluentsql.DB, opts *options, out io.Writer) error {
+	m, err := migrator(db, opts)
+	if err != nil {
+		return err
+	}
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Ran?\tBatch\tMigration")
+	for _, s := range status {
+		ran, batch := "No", ""
+		if s.Applied {
+			ran, batch = "Yes", fmt.Sprint(s.Batch)
+		}
+		name := s.Name
+		switch {
+		case s.Missing:
+			name += " (missing)"
+		case s.Modified:
+			name += " (modified)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", ran, batch, name)
+	}
+	return w.Flush()
+}
+
+// migrateMake, boş up/down dosyaları oluşturur. Veritabanı bağlantısı
+// gerekmez.
+func migrateMake(opts *options, name string, out io.Writer) error {
+	if !migrationName.MatchString(name) {
+		return fmt.Errorf("%w: migration name %q must contain only lowercase letters, digits and underscores", errUsage, name)
+	}
+	return writeMigration(opts, name, "", out)
+}
+
+// writeMigration, <timestamp>_<name>.up.sql / .down.sql dosyalarını oluşturur;
+// up dosyasına verilen ifadeler yazılır.
+func writeMigration(opts *options, name, up string, out io.Writer) error {
+	if err := os.MkdirAll(opts.migrationsDir, 0o755); err != nil {
+		return err
+	}
+
+	base := time.Now().UTC().Format("20060102150405") + "_" + name
+	for _, file := range []struct{ suffix, body string }{{".up.sql", up}, {".down.sql", ""}} {
+		path := filepath.Join(opts.migrationsDir, base+file.suffix)
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(f, "-- %s%s\n", base, file.suffix)
+		if file.body != "" {
+			fmt.Fprintf(f, "\n%s", file.body)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Created:     %s\n", path)
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// schema, db, sql
+// ---------------------------------------------------------------------------
+
+// schemaDump, tüm tabloların CREATE ifadelerini yazar.
+func schemaDump(ctx context.Context, db *fluentsql.DB, opts *options, out io.Writer) error {
+	var stmts []string
+	var err error
+	switch opts.config.Driver {
+	case "mysql":
+		stmts, err = dumpMySQL(ctx, db)
+	case "sqlite":
+		stmts, err = queryStrings(ctx, db, `SELECT sql FROM sqlite_master
+			WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%'
+			ORDER BY CASE type WHEN 'table' THEN 0 ELSE 1 END, name`)
+	default:
+		return fmt.Errorf("schema dump is not supported for %s; use pg_dump --schema-only", opts.config.Driver)
+	}
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		fmt.Fprintf(out, "%s;\n\n", stmt)
+	}
+	return nil
+}
+
+func dumpMySQL(ctx context.Context, db *fluentsql.DB) ([]string, error) {
+	tables, err := queryStrings(ctx, db, "SHOW TABLES")
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]string, 0, len(tables))
+	for _, table := range tables {
+		var name, create string
+		q := "SHOW CREATE TABLE `" + strings.ReplaceAll(table, "`", "``") + "`"
+		if err := db.QueryRowContext(ctx, q).Scan(&name, &create); err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, create)
+	}
+	return stmts, nil
+}
+
+func dbPing(ctx context.Context, db *fluentsql.DB, opts *options, out io.Writer) error {
+	start := time.Now()
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "OK (%s, %s)\n", opts.config.Driver, time.Since(start).Round(time.Microsecond))
+	return nil
+}
+
+// sqlExplain, tek bir sorgunun planını yazar. Sorgu çalıştırılmaz.
+func sqlExplain(ctx context.Context, db *fluentsql.DB, opts *options, query string, out io.Writer) error {
+	stmts := migrate.SplitStatements(query)
+	if len(stmts) != 1 {
+		return fmt.Errorf("%w: sql explain expects exactly one statement, got %d", errUsage, len(stmts))
+	}
+	query = stmts[0]
+
+	switch opts.config.Driver {
+	case "mysql":
+		plan, err := db.Explain(ctx, query)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Cost: %.2f\n", plan.Cost)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Table\tAccess\tKey\tRows\tFiltered")
+		for _, t := range plan.Tables {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%.2f%%\n", t.Name, t.AccessType, t.Key, t.Rows, t.Filtered)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if scans := plan.FullScans(); len(scans) > 0 {
+			fmt.Fprintf(out, "Full table scans: %s\n", strings.Join(scans, ", "))
+		}
+		return nil
+	case "sqlite":
+		return printRows(ctx, db, "EXPLAIN QUERY PLAN "+query, "detail", out)
+	default:
+		return printRows(ctx, db, "EXPLAIN "+query, "", out)
+	}
+}
+
+// queryStrings, tek sütunlu bir sorgunun sonuçlarını döndürür.
+func queryStrings(ctx context.Context, db *fluentsql.DB, query string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// printRows, sorgu sonucunun column sütununu (boşsa ilk sütunu) satır satır
+// yazar.
+func printRows(ctx context.Context, db *fluentsql.DB, query, column string, out io.Writer) error {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	idx := 0
+	for i, c := range cols {
+		if c == column {
+			idx = i
+		}
+	}
+	values := make([]any, len(cols))
+	for i := range values {
+		values[i] = new(sql.RawBytes)
+	}
+	for rows.Next() {
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(*values[idx].(*sql.RawBytes)))
+	}
+	return rows.Err()
+}
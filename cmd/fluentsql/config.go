@@ -0,0 +1,330 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"gopkg.in/yaml.v3"
+)
+
+// -----------------------------------------------------------------------------
+//  Yapılandırma — YAML Dosyası, Ortam Değişkenleri ve Bayraklar
+//
+//  Ayarlar katman katman okunur; her katman yalnızca verdiği alanları ezer:
+//
+//   1. fluentsql.DefaultConfig()
+//   2. YAML dosyası (-config, FLUENTSQL_CONFIG veya ./fluentsql.yaml)
+//   3. FLUENTSQL_* ortam değişkenleri
+//   4. Komut satırında açıkça verilen bayraklar
+//
+//  YAML içeriğindeki ${VAR} ifadeleri ortam değişkenleriyle genişletilir;
+//  böylece parolalar dosyaya yazılmadan CI gizli değişkenlerinden alınabilir.
+//  Süslü parantezsiz "$" olduğu gibi kalır ("pa$$word").
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// defaultConfigFile, -config verilmediğinde çalışma dizininde aranan dosyadır.
+const defaultConfigFile = "fluentsql.yaml"
+
+// options, bir komutun ihtiyaç duyduğu tüm ayarlardır.
+type options struct {
+	config         *fluentsql.Config
+	migrationsDir  string
+	migrationTable string
+	modelsDir      string
+	allowDrops     bool
+	allowLossy     bool
+	steps          int
+	gen            genOptions
+}
+
+// genOptions, "gen" komutunun ayarlarıdır.
+type genOptions struct {
+	pkg      string
+	out      string
+	nullable string
+	tables   []string
+}
+
+// fileConfig, YAML dosyasının biçimidir. Pointer alanlar, dosyada
+// bulunmayan ayarların varsayılanı ezmemesini sağlar.
+type fileConfig struct {
+	Driver     *string `yaml:"driver"`
+	Host       *string `yaml:"host"`
+	Port       *int    `yaml:"port"`
+	Database   *string `yaml:"database"`
+	Username   *string `yaml:"username"`
+	Password   *string `yaml:"password"`
+	Charset    *string `yaml:"charset"`
+	Collation  *string `yaml:"collation"`
+	Prefix     *string `yaml:"prefix"`
+	TLS        *bool   `yaml:"tls"`
+	Migrations struct {
+		Dir    *string `yaml:"dir"`
+		Table  *string `yaml:"table"`
+		Models *string `yaml:"models"`
+	} `yaml:"migrations"`
+	Gen struct {
+		Package  *string  `yaml:"package"`
+		Out      *string  `yaml:"out"`
+		Nullable *string  `yaml:"nullable"`
+		Tables   []string `yaml:"tables"`
+	} `yaml:"gen"`
+}
+
+// cliFlags, komut satırı bayraklarının hedefleridir.
+type cliFlags struct {
+	config, driver, host, database, username, password, prefix string
+	dir, table, models                                         string
+	pkg, out, nullable, tables                                 string
+	port, steps                                                int
+	tls, allowDrops, allowLossy                                bool
+}
+
+func registerFlags(fs *flag.FlagSet) *cliFlags {
+	f := &cliFlags{}
+	fs.StringVar(&f.config, "config", "", "YAML config file (default $FLUENTSQL_CONFIG or ./"+defaultConfigFile+")")
+	fs.StringVar(&f.driver, "driver", "", "database driver: mysql, postgres or sqlite")
+	fs.StringVar(&f.host, "host", "", "database host")
+	fs.IntVar(&f.port, "port", 0, "database port")
+	fs.StringVar(&f.database, "database", "", "database name (file path for sqlite)")
+	fs.StringVar(&f.username, "username", "", "database user")
+	fs.StringVar(&f.password, "password", "", "database password")
+	fs.StringVar(&f.prefix, "prefix", "", "table prefix")
+	fs.BoolVar(&f.tls, "tls", false, "require TLS")
+	fs.StringVar(&f.dir, "dir", "migrations", "migrations directory")
+	fs.StringVar(&f.table, "table", "", "migration history table (default \"migrations\")")
+	fs.IntVar(&f.steps, "steps", 0, "number of migrations to roll back (migrate down)")
+	fs.StringVar(&f.models, "models", "models", "directory of Go model structs (migrate diff)")
+	fs.BoolVar(&f.allowDrops, "allow-drops", false, "include DROP COLUMN / DROP INDEX statements (migrate diff)")
+	fs.BoolVar(&f.allowLossy, "allow-lossy", false, "include narrowing or cross-type column changes (migrate diff)")
+	fs.StringVar(&f.pkg, "package", "models", "package name of generated code (gen)")
+	fs.StringVar(&f.out, "out", "", "output file of generated code, stdout if empty (gen)")
+	fs.StringVar(&f.nullable, "nullable", nullableSQL, "nullable columns as \"sql\" (sql.Null*) or \"pointer\" (gen)")
+	fs.StringVar(&f.tables, "tables", "", "comma separated tables to generate, all if empty (gen)")
+	return f
+}
+
+// loadOptions, katmanları sırasıyla uygulayarak ayarları oluşturur.
+func loadOptions(fs *flag.FlagSet, f *cliFlags) (*options, error) {
+	opts := &options{
+		config:        fluentsql.DefaultConfig(),
+		migrationsDir: f.dir,
+		modelsDir:     f.models,
+		gen:           genOptions{pkg: f.pkg, nullable: f.nullable},
+	}
+	portSet := false
+
+	set := map[string]bool{}
+	fs.Visit(func(fl *flag.Flag) { set[fl.Name] = true })
+
+	// 1. YAML dosyası
+	path, required := f.config, set["config"]
+	if !required {
+		if path = os.Getenv("FLUENTSQL_CONFIG"); path != "" {
+			required = true
+		} else {
+			path = defaultConfigFile
+		}
+	}
+	fc, err := readConfigFile(path, required)
+	if err != nil {
+		return nil, err
+	}
+	if fc != nil {
+		portSet = fc.Port != nil
+		fc.apply(opts)
+	}
+
+	// 2. Ortam değişkenleri
+	envPort, err := applyEnv(opts)
+	if err != nil {
+		return nil, err
+	}
+	portSet = portSet || envPort
+
+	// 3. Açıkça verilen bayraklar
+	c := opts.config
+	for name := range set {
+		switch name {
+		case "driver":
+			c.Driver = f.driver
+		case "host":
+			c.Host = f.host
+		case "port":
+			c.Port, portSet = f.port, true
+		case "database":
+			c.Database = f.database
+		case "username":
+			c.Username = f.username
+		case "password":
+			c.Password = f.password
+		case "prefix":
+			c.Prefix = f.prefix
+		case "tls":
+			c.TLS = f.tls
+		case "dir":
+			opts.migrationsDir = f.dir
+		case "table":
+			opts.migrationTable = f.table
+		case "models":
+			opts.modelsDir = f.models
+		case "package":
+			opts.gen.pkg = f.pkg
+		case "out":
+			opts.gen.out = f.out
+		case "nullable":
+			opts.gen.nullable = f.nullable
+		case "tables":
+			opts.gen.tables = splitList(f.tables)
+		}
+	}
+	opts.steps = f.steps
+	opts.allowDrops = f.allowDrops
+	opts.allowLossy = f.allowLossy
+	if opts.steps < 0 {
+		return nil, fmt.Errorf("%w: -steps must not be negative", errUsage)
+	}
+
+	c.Driver = normalizeDriver(c.Driver)
+	if c.Driver == "postgres" && !portSet {
+		c.Port = 5432
+	}
+	return opts, nil
+}
+
+// readConfigFile, YAML dosyasını okur. Dosya açıkça istenmemişse ve yoksa
+// nil döner.
+func readConfigFile(path string, required bool) (*fileConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !required && errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	fc := &fileConfig{}
+	if err := yaml.Unmarshal(expandEnv(data), fc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return fc, nil
+}
+
+// envRef, YAML içinde genişletilen ${NAME} ifadesidir.
+var envRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnv, yalnızca ${NAME} ifadelerini ortam değişkeniyle değiştirir.
+// os.ExpandEnv'in aksine "$1" veya "$$" gibi çıplak dolar işaretlerine
+// dokunmaz; tanımsız değişkenler boş dizeye genişler.
+func expandEnv(data []byte) []byte {
+	return envRef.ReplaceAllFunc(data, func(ref []byte) []byte {
+		return []byte(os.Getenv(string(ref[2 : len(ref)-1])))
+	})
+}
+
+func (fc *fileConfig) apply(opts *options) {
+	c := opts.config
+	setString(&c.Driver, fc.Driver)
+	setString(&c.Host, fc.Host)
+	setString(&c.Database, fc.Database)
+	setString(&c.Username, fc.Username)
+	setString(&c.Password, fc.Password)
+	setString(&c.Charset, fc.Charset)
+	setString(&c.Collation, fc.Collation)
+	setString(&c.Prefix, fc.Prefix)
+	setString(&opts.migrationsDir, fc.Migrations.Dir)
+	setString(&opts.migrationTable, fc.Migrations.Table)
+	setString(&opts.modelsDir, fc.Migrations.Models)
+	setString(&opts.gen.pkg, fc.Gen.Package)
+	setString(&opts.gen.out, fc.Gen.Out)
+	setString(&opts.gen.nullable, fc.Gen.Nullable)
+	if fc.Gen.Tables != nil {
+		opts.gen.tables = fc.Gen.Tables
+	}
+	if fc.Port != nil {
+		c.Port = *fc.Port
+	}
+	if fc.TLS != nil {
+		c.TLS = *fc.TLS
+	}
+}
+
+func setString(dst *string, v *string) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+// applyEnv, FLUENTSQL_* ortam değişkenlerini uygular ve port'un verilip
+// verilmediğini bildirir.
+func applyEnv(opts *options) (bool, error) {
+	c := opts.config
+	strs := map[string]*string{
+		"FLUENTSQL_DRIVER":           &c.Driver,
+		"FLUENTSQL_HOST":             &c.Host,
+		"FLUENTSQL_DATABASE":         &c.Database,
+		"FLUENTSQL_USERNAME":         &c.Username,
+		"FLUENTSQL_PASSWORD":         &c.Password,
+		"FLUENTSQL_PREFIX":           &c.Prefix,
+		"FLUENTSQL_MIGRATIONS_DIR":   &opts.migrationsDir,
+		"FLUENTSQL_MIGRATIONS_TABLE": &opts.migrationTable,
+	}
+	for key, dst := range strs {
+		if v, ok := os.LookupEnv(key); ok {
+			*dst = v
+		}
+	}
+
+	if v, ok := os.LookupEnv("FLUENTSQL_TLS"); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("FLUENTSQL_TLS: %w", err)
+		}
+		c.TLS = b
+	}
+	v, ok := os.LookupEnv("FLUENTSQL_PORT")
+	if !ok {
+		return false, nil
+	}
+	port, err := strconv.Atoi(v)
+	if err != nil {
+		return false, fmt.Errorf("FLUENTSQL_PORT: %w", err)
+	}
+	c.Port = port
+	return true, nil
+}
+
+// normalizeDriver, sürücü adını bu araçta kayıtlı sürücü adına çevirir.
+func normalizeDriver(name string) string {
+	switch strings.ToLower(name) {
+	case "postgresql", "pgx", "postgres":
+		return "postgres"
+	case "sqlite3", "sqlite":
+		return "sqlite"
+	case "mariadb", "mysql":
+		return "mysql"
+	default:
+		return name
+	}
+}
+
+// splitList, virgülle ayrılmış bir listeyi boş elemanları atarak böler.
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
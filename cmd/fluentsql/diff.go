@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+// -----------------------------------------------------------------------------
+//  Şema Farkı — Go Struct'larından Migration
+//
+//  "fluentsql migrate diff", -models dizinindeki Go dosyalarını derlemeden
+//  okur ve TableName() metodu string sabiti döndüren her struct'ı canlı
+//  tabloyla karşılaştırır (bkz. schema.Builder.Diff). "fluentsql gen"
+//  çıktısı doğrudan kullanılabilir.
+//
+//  Ad verilmezse ifadeler stdout'a yazılır; "migrate diff <name>" ifadeleri
+//  make ile aynı adlandırmayla yeni bir migration dosyasına yazar. Sütun ve
+//  index silme ifadeleri -allow-drops, daraltan veya tür ailesini değiştiren
+//  sütun değişiklikleri -allow-lossy verilmedikçe yalnızca yorum olarak
+//  gösterilir. Dialect'in değiştiremediği sütunlar (SQLite) yorumla
+//  raporlanır. Modellerde bulunmayan tablolara dokunulmaz.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// model, modeller dizininde bulunan tek bir tablonun tanımıdır.
+type model struct {
+	Struct string
+	Table  string
+	Fields []schema.Field
+}
+
+// migrateDiff, modelleri canlı şemayla karşılaştırır. name boş değilse
+// ifadeler yeni bir migration'a yazılır.
+func migrateDiff(ctx context.Context, db *fluentsql.DB, opts *options, name string, out io.Writer) error {
+	if name != "" && !migrationName.MatchString(name) {
+		return fmt.Errorf("%w: migration name %q must contain only lowercase letters, digits and underscores", errUsage, name)
+	}
+	models, err := parseModels(opts.modelsDir)
+	if err != nil {
+		return err
+	}
+	grammar, err := schema.GrammarFor(opts.config.Driver)
+	if err != nil {
+		return err
+	}
+	builder := schema.NewBuilder(db, grammar)
+	inspector := db.Schema().WithDialect(opts.config.Driver)
+
+	var buf strings.Builder
+	for _, m := range models {
+		bp, err := schema.FromFields(m.Table, opts.config.Prefix, m.Fields)
+		if err != nil {
+			return fmt.Errorf("%s: %w", m.Struct, err)
+		}
+		d, err := builder.Diff(ctx, inspector, bp, schema.DiffOptions{AllowDrops: opts.allowDrops, AllowLossyChanges: opts.allowLossy})
+		if err != nil {
+			return err
+		}
+		if d.Empty() {
+			continue
+		}
+
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "-- %s\n", d.Table)
+		for _, stmt := range d.Statements {
+			buf.WriteString(stmt + ";\n")
+		}
+		if len(d.Skipped) > 0 {
+			buf.WriteString("-- Skipped, rerun with -allow-drops or -allow-lossy to apply:\n")
+			for _, stmt := range d.Skipped {
+				buf.WriteString("-- " + stmt + ";\n")
+			}
+		}
+		if len(d.Unsupported) > 0 {
+			fmt.Fprintf(&buf, "-- Skipped, %s cannot change these columns in place (rebuild the table): %s\n",
+				grammar.Name(), strings.Join(d.Unsupported, ", "))
+		}
+	}
+
+	switch {
+	case buf.Len() == 0:
+		fmt.Fprintln(out, "Schema is up to date.")
+		return nil
+	case name == "":
+		_, err = io.WriteString(out, buf.String())
+		return err
+	default:
+		return writeMigration(opts, name, buf.String(), out)
+	}
+}
+
+// parseModels, dizindeki Go dosyalarından tablo modellerini okur. Yalnızca
+// TableName() metodu string sabiti döndüren struct'lar modeldir.
+func parseModels(dir string) ([]model, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	structs := map[string]*ast.StructType{}
+	tables := map[string]string{}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := ts.Type.(*ast.StructType); ok {
+							structs[ts.Name.Name] = st
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if recv, table, ok := tableNameMethod(decl); ok {
+					tables[recv] = table
+				}
+			}
+		}
+	}
+
+	var models []model
+	for name, table := range tables {
+		st, ok := structs[name]
+		if !ok {
+			continue
+		}
+		fields, err := structFields(structs, name, st)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, model{Struct: name, Table: table, Fields: fields})
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("no structs with a TableName() method found in %s", dir)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Table < models[j].Table })
+	return models, nil
+}
+
+// tableNameMethod, "func (T) TableName() string { return "t" }" biçimindeki
+// metodun alıcı tipini ve döndürdüğü tablo adını verir.
+func tableNameMethod(fn *ast.FuncDecl) (string, string, bool) {
+	if fn.Name.Name != "TableName" || fn.Recv == nil || len(fn.Recv.List) != 1 ||
+		fn.Type.Params.NumFields() != 0 || fn.Body == nil || len(fn.Body.List) != 1 {
+		return "", "", false
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", "", false
+	}
+	table, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", "", false
+	}
+	return ident.Name, table, true
+}
+
+// structFields, DefaultScanner'ın kurallarıyla struct'ın sütunlarını toplar.
+// Gömülü struct'lar aynı dizinde tanımlı olmalıdır.
+func structFields(structs map[string]*ast.StructType, name string, st *ast.StructType) ([]schema.Field, error) {
+	var fields []schema.Field
+	for _, f := range st.Fields.List {
+		tag := ""
+		if f.Tag != nil {
+			raw, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(raw).Get("db")
+		}
+
+		if len(f.Names) == 0 {
+			ident, ok := f.Type.(*ast.Ident)
+			if !ok || structs[ident.Name] == nil {
+				return nil, fmt.Errorf("%s: embedded field %s must be a struct declared in the models directory", name, types.ExprString(f.Type))
+			}
+			embedded, err := structFields(structs, ident.Name, structs[ident.Name])
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embedded...)
+			continue
+		}
+
+		for _, n := range f.Names {
+			if !n.IsExported() || tag == "-" {
+				continue
+			}
+			field := schema.Field{Column: strings.ToLower(n.Name), GoType: types.ExprString(f.Type)}
+			if tag != "" {
+				parts := strings.Split(tag, ",")
+				field.Column = parts[0]
+				for _, p := range parts[1:] {
+					field.PK = field.PK || p == "pk"
+				}
+			}
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
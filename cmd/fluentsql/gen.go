@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/migrate"
+)
+
+// -----------------------------------------------------------------------------
+//  Kod Üretici — Canlı Şemadan Go Struct'ları
+//
+//  "fluentsql gen", DB.Schema() ile tabloları okur ve her tablo için:
+//
+//   • DefaultScanner'ın anladığı `db:"kolon"` / `db:"kolon,pk"` tag'li bir struct,
+//   • kolon adları için sabitler (UserColumnEmail = "email"),
+//   • tablo adını döndüren TableName() metodu
+//
+//  üretir. Struct adı tablo adının tekil CamelCase hâlidir; tablo öneki
+//  (-prefix) struct adından ve TableName()'den çıkarılır, çünkü Builder öneki
+//  kendisi ekler. Nullable kolonlar -nullable ile sql.Null* tiplerine (sql)
+//  veya pointer'lara (pointer) eşlenir. Migration history tablosu atlanır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Nullable kolon gösterimleri.
+const (
+	nullableSQL     = "sql"
+	nullablePointer = "pointer"
+)
+
+// initialisms, CamelCase dönüşümünde tamamı büyük harf yazılan kısaltmalardır.
+var initialisms = map[string]bool{
+	"api": true, "db": true, "html": true, "http": true, "id": true, "ip": true,
+	"json": true, "sql": true, "ssl": true, "uid": true, "uri": true, "url": true, "uuid": true,
+}
+
+// genTable, üretilecek tek bir tablonun modelidir.
+type genTable struct {
+	Name    string // Fiziksel tablo adı
+	Logical string // Önek çıkarılmış ad; TableName() bunu döndürür
+	Struct  string
+	Columns []genColumn
+}
+
+type genColumn struct {
+	Name  string
+	Field string
+	Type  string
+	PK    bool
+}
+
+// genCommand, şemayı okuyup Go kaynağını yazar.
+func genCommand(ctx context.Context, db *fluentsql.DB, opts *options, out io.Writer) error {
+	if opts.gen.nullable != nullableSQL && opts.gen.nullable != nullablePointer {
+		return fmt.Errorf("%w: -nullable must be %q or %q", errUsage, nullableSQL, nullablePointer)
+	}
+	if !token.IsIdentifier(opts.gen.pkg) {
+		return fmt.Errorf("%w: -package %q is not a valid Go identifier", errUsage, opts.gen.pkg)
+	}
+
+	inspector := db.Schema().WithDialect(opts.config.Driver)
+	tables := opts.gen.tables
+	if len(tables) == 0 {
+		all, err := inspector.Tables(ctx)
+		if err != nil {
+			return err
+		}
+		history := opts.migrationTable
+		if history == "" {
+			history = migrate.DefaultTable
+		}
+		history = fluentsql.PrefixTable(db.TablePrefix(), db.UnprefixedTables(), history)
+		for _, t := range all {
+			if t != history {
+				tables = append(tables, t)
+			}
+		}
+	}
+
+	var models []genTable
+	for _, table := range tables {
+		cols, err := inspector.Columns(ctx, table)
+		if err != nil {
+			return err
+		}
+		if len(cols) == 0 {
+			return fmt.Errorf("table %q does not exist or has no columns", table)
+		}
+		models = append(models, buildModel(table, opts.config.Prefix, cols, opts.gen.nullable))
+	}
+
+	src, err := renderModels(opts.gen.pkg, models)
+	if err != nil {
+		return err
+	}
+	if opts.gen.out == "" || opts.gen.out == "-" {
+		_, err = out.Write(src)
+		return err
+	}
+	if err := os.WriteFile(opts.gen.out, src, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Generated:   %s (%d tables)\n", opts.gen.out, len(models))
+	return nil
+}
+
+// buildModel, tablonun kolonlarından struct modelini oluşturur.
+func buildModel(table, prefix string, cols []fluentsql.ColumnInfo, nullable string) genTable {
+	logical := table
+	if prefix != "" && strings.HasPrefix(table, prefix) && len(table) > len(prefix) {
+		logical = strings.TrimPrefix(table, prefix)
+	}
+
+	m := genTable{Name: table, Logical: logical, Struct: goName(singular(logical))}
+	for _, c := range cols {
+		field := goName(c.Name)
+		if field == "TableName" {
+			field = "TableNameColumn" // TableName() metoduyla çakışmasın
+		}
+		m.Columns = append(m.Columns, genColumn{
+			Name:  c.Name,
+			Field: field,
+			Type:  goType(c, nullable),
+			PK:    c.PrimaryKey,
+		})
+	}
+	return m
+}
+
+// renderModels, modellerden biçimlendirilmiş Go kaynağı üretir. Aynı Go
+// adına düşen tablo veya kolonlar hatadır.
+func renderModels(pkg string, models []genTable) ([]byte, error) {
+	sort.Slice(models, func(i, j int) bool { return models[i].Struct < models[j].Struct })
+
+	idents := map[string]string{} // Go adı → kaynağı
+	claim := func(ident, source string) error {
+		if prev, ok := idents[ident]; ok {
+			return fmt.Errorf("%s and %s both map to the Go name %s", prev, source, ident)
+		}
+		idents[ident] = source
+		return nil
+	}
+
+	imports := map[string]bool{}
+	var body bytes.Buffer
+	for _, m := range models {
+		if err := claim(m.Struct, fmt.Sprintf("table %q", m.Name)); err != nil {
+			return nil, err
+		}
+		fields := map[string]string{}
+		for _, c := range m.Columns {
+			if prev, ok := fields[c.Field]; ok {
+				return nil, fmt.Errorf("columns %q and %q of table %q both map to the field %s", prev, c.Name, m.Name, c.Field)
+			}
+			fields[c.Field] = c.Name
+			if err := claim(m.Struct+"Column"+c.Field, fmt.Sprintf("column %q of table %q", c.Name, m.Name)); err != nil {
+				return nil, err
+			}
+			if strings.Contains(c.Type, "sql.") {
+				imports["database/sql"] = true
+			}
+			if strings.Contains(c.Type, "time.") {
+				imports["time"] = true
+			}
+		}
+
+		fmt.Fprintf(&body, "\n// %s is a row of the %s table.\n", m.Struct, m.Name)
+		fmt.Fprintf(&body, "type %s struct {\n", m.Struct)
+		for _, c := range m.Columns {
+			tag := c.Name
+			if c.PK {
+				tag += ",pk"
+			}
+			fmt.Fprintf(&body, "\t%s %s `db:%q`\n", c.Field, c.Type, tag)
+		}
+		body.WriteString("}\n")
+
+		fmt.Fprintf(&body, "\n// Column names of the %s table.\nconst (\n", m.Name)
+		for _, c := range m.Columns {
+			fmt.Fprintf(&body, "\t%sColumn%s = %q\n", m.Struct, c.Field, c.Name)
+		}
+		body.WriteString(")\n")
+
+		fmt.Fprintf(&body, "\n// TableName returns the table name without the table prefix.\n")
+		fmt.Fprintf(&body, "func (%s) TableName() string {\n\treturn %q\n}\n", m.Struct, m.Logical)
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by fluentsql gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n", pkg)
+	if len(imports) > 0 {
+		paths := make([]string, 0, len(imports))
+		for p := range imports {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		src.WriteString("\nimport (\n")
+		for _, p := range paths {
+			fmt.Fprintf(&src, "\t%q\n", p)
+		}
+		src.WriteString(")\n")
+	}
+	src.Write(body.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+// goType, kolonun Go tipini döndürür. Tanınmayan tipler string'e eşlenir.
+func goType(c fluentsql.ColumnInfo, nullable string) string {
+	base, null := "string", "sql.NullString"
+	switch t := c.Type; {
+	case c.FullType == "tinyint(1)" || t == "boolean" || t == "bool":
+		base, null = "bool", "sql.NullBool"
+	case (strings.HasSuffix(t, "int") && t != "point") || t == "integer" || t == "year" ||
+		t == "int2" || t == "int4" || t == "int8" || strings.HasSuffix(t, "serial"):
+		base, null = "int64", "sql.NullInt64"
+	case t == "float" || t == "double" || t == "real" || strings.HasPrefix(t, "double") || strings.HasPrefix(t, "float"):
+		base, null = "float64", "sql.NullFloat64"
+	case t == "date" || t == "datetime" || strings.HasPrefix(t, "timestamp"):
+		base, null = "time.Time", "sql.NullTime"
+	case strings.Contains(t, "blob") || strings.Contains(t, "binary") || t == "bytea":
+		return "[]byte" // nil, NULL'u zaten ifade eder
+	}
+
+	if !c.Nullable {
+		return base
+	}
+	if nullable == nullablePointer {
+		return "*" + base
+	}
+	return null
+}
+
+// goName, snake_case bir adı dışa açık CamelCase Go adına çevirir.
+func goName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, p := range parts {
+		lower := strings.ToLower(p)
+		if initialisms[lower] {
+			b.WriteString(strings.ToUpper(lower))
+			continue
+		}
+		r := []rune(p)
+		b.WriteString(strings.ToUpper(string(r[0])) + string(r[1:]))
+	}
+	ident := b.String()
+	if ident == "" || unicode.IsDigit([]rune(ident)[0]) {
+		ident = "X" + ident
+	}
+	return ident
+}
+
+// singular, İngilizce tablo adının son kelimesini basitçe tekilleştirir
+// ("user_roles" → "user_role", "categories" → "category").
+func singular(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(name) > 3:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(lower, "sses"), strings.HasSuffix(lower, "xes"),
+		strings.HasSuffix(lower, "ches"), strings.HasSuffix(lower, "shes"):
+		return name[:len(name)-2]
+	case strings.HasSuffix(lower, "s") && !strings.HasSuffix(lower, "ss") &&
+		!strings.HasSuffix(lower, "us") && !strings.HasSuffix(lower, "is"):
+		return name[:len(name)-1]
+	default:
+		return name
+	}
+}
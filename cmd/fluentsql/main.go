@@ -0,0 +1,179 @@
+// Command fluentsql, go-fluent-sql için veritabanı yönetim aracıdır.
+//
+// Go kodu yazmadan migration çalıştırmayı, şema dökmeyi ve sorgu planlarını
+// incelemeyi sağlar:
+//
+//	fluentsql migrate up|down|status
+//	fluentsql migrate make <name>
+//	fluentsql migrate diff [name] -models ./models [-allow-drops] [-allow-lossy]
+//	fluentsql schema dump
+//	fluentsql db ping
+//	fluentsql sql explain "<query>"
+//	fluentsql gen -package models -out models/models_gen.go
+//
+// Bağlantı ayarları sırasıyla YAML dosyasından (-config, FLUENTSQL_CONFIG
+// veya çalışma dizinindeki fluentsql.yaml), FLUENTSQL_* ortam
+// değişkenlerinden ve komut satırı bayraklarından okunur; sonraki kaynak
+// öncekini ezer.
+//
+// Ayrı bir Go modülüdür; MySQL, PostgreSQL ve SQLite sürücülerini yalnızca
+// bu araç içerir, çekirdek paket bağımlılıksız kalır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+const usage = `Usage: fluentsql [flags] <command>
+
+Commands:
+  migrate up                 Run pending migrations
+  migrate down [-steps N]    Roll back the last batch (or the last N migrations)
+  migrate status             Show applied and pending migrations
+  migrate make <name>        Create <timestamp>_<name>.up.sql / .down.sql
+  migrate diff [name]        Print (or write as a migration) the ALTER statements
+                             that bring the schema in line with -models structs
+  schema dump                Print CREATE statements of all tables
+  db ping                    Check the connection
+  sql explain "<query>"      Show the execution plan of a query
+  gen [-out file]            Generate Go structs from the live schema
+
+Flags:
+`
+
+// errUsage, hatalı komut kullanımını belirtir; çıkış kodu 2'dir.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run, komutu çalıştırır ve çıkış kodunu döndürür.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fluentsql", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	flags := registerFlags(fs)
+
+	// Bayraklar komutlardan önce veya sonra yazılabilir
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return 0
+			}
+			return 2
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	opts, err := loadOptions(fs, flags)
+	if err == nil {
+		err = dispatch(ctx, opts, positional, stdout)
+	}
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(stderr, "fluentsql: %v\n\n", err)
+		fs.Usage()
+		return 2
+	default:
+		fmt.Fprintf(stderr, "fluentsql: %v\n", err)
+		return 1
+	}
+}
+
+// dispatch, konumsal argümanlara göre komutu seçer.
+func dispatch(ctx context.Context, opts *options, args []string, out io.Writer) error {
+	cmd := strings.Join(head(args, 2), " ")
+	rest := tail(args, 2)
+	if len(args) > 0 && args[0] == "gen" {
+		cmd, rest = "gen", args[1:]
+	}
+
+	switch cmd {
+	case "migrate make":
+		if len(rest) != 1 {
+			return fmt.Errorf("%w: migrate make requires a name", errUsage)
+		}
+		return migrateMake(opts, rest[0], out)
+	case "migrate up", "migrate down", "migrate status", "migrate diff", "schema dump", "db ping", "sql explain", "gen":
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, strings.Join(args, " "))
+	}
+
+	switch {
+	case cmd == "sql explain" && len(rest) != 1:
+		return fmt.Errorf("%w: sql explain requires one quoted query", errUsage)
+	case cmd == "migrate diff" && len(rest) > 1:
+		return fmt.Errorf("%w: migrate diff takes at most one migration name", errUsage)
+	case cmd != "sql explain" && cmd != "migrate diff" && len(rest) != 0:
+		return fmt.Errorf("%w: unexpected arguments %q", errUsage, rest)
+	}
+
+	db, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	switch cmd {
+	case "migrate up":
+		return migrateUp(ctx, db, opts, out)
+	case "migrate down":
+		return migrateDown(ctx, db, opts, out)
+	case "migrate status":
+		return migrateStatus(ctx, db, opts, out)
+	case "migrate diff":
+		return migrateDiff(ctx, db, opts, strings.Join(rest, ""), out)
+	case "schema dump":
+		return schemaDump(ctx, db, opts, out)
+	case "db ping":
+		return dbPing(ctx, db, opts, out)
+	case "gen":
+		return genCommand(ctx, db, opts, out)
+	default:
+		return sqlExplain(ctx, db, opts, rest[0], out)
+	}
+}
+
+func head(s []string, n int) []string {
+	if len(s) < n {
+		return s
+	}
+	return s[:n]
+}
+
+func tail(s []string, n int) []string {
+	if len(s) < n {
+		return nil
+	}
+	return s[n:]
+}
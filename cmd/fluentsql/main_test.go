@@ -0,0 +1,391 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runCLI, aracı verilen argümanlarla çalıştırır ve çıktıları döndürür.
+func runCLI(t *testing.T, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func mustRun(t *testing.T, args ...string) string {
+	t.Helper()
+	out, errOut, code := runCLI(t, args...)
+	if code != 0 {
+		t.Fatalf("fluentsql %s exited %d: %s", strings.Join(args, " "), code, errOut)
+	}
+	return out
+}
+
+// clearEnv, testin ortamdaki FLUENTSQL_* değişkenlerinden etkilenmesini önler.
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, kv := range os.Environ() {
+		if key, _, _ := strings.Cut(kv, "="); strings.HasPrefix(key, "FLUENTSQL_") {
+			t.Setenv(key, "")
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestSQLiteWorkflow(t *testing.T) {
+	clearEnv(t)
+	dir := t.TempDir()
+	migrations := filepath.Join(dir, "migrations")
+	conn := []string{"-driver", "sqlite3", "-database", filepath.Join(dir, "app.db"), "-dir", migrations}
+
+	out := mustRun(t, append([]string{"migrate", "make", "create_users"}, conn...)...)
+	if !strings.Contains(out, "_create_users.up.sql") || !strings.Contains(out, "_create_users.down.sql") {
+		t.Fatalf("migrate make output = %q", out)
+	}
+	files, _ := filepath.Glob(filepath.Join(migrations, "*_create_users.up.sql"))
+	if len(files) != 1 {
+		t.Fatalf("created files = %v", files)
+	}
+	up := "CREATE TABLE users (id INTEGER PRIMARY KEY, email TEXT NOT NULL);\nCREATE INDEX users_email_index ON users (email);\n"
+	if err := os.WriteFile(files[0], []byte(up), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(strings.Replace(files[0], ".up.sql", ".down.sql", 1), []byte("DROP TABLE users;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if out := mustRun(t, append(conn, "migrate", "status")...); !strings.Contains(out, "No") {
+		t.Errorf("status before up = %q", out)
+	}
+	if out := mustRun(t, append([]string{"migrate", "up"}, conn...)...); !strings.Contains(out, "Migrated:") {
+		t.Errorf("migrate up output = %q", out)
+	}
+	if out := mustRun(t, append([]string{"migrate", "up"}, conn...)...); !strings.Contains(out, "Nothing to migrate.") {
+		t.Errorf("second migrate up output = %q", out)
+	}
+	if out := mustRun(t, append([]string{"migrate", "status"}, conn...)...); !strings.Contains(out, "Yes   1") {
+		t.Errorf("status after up = %q", out)
+	}
+
+	dump := mustRun(t, append([]string{"schema", "dump"}, conn...)...)
+	if !strings.Contains(dump, "CREATE TABLE users") || !strings.Contains(dump, "CREATE INDEX users_email_index") {
+		t.Errorf("schema dump = %q", dump)
+	}
+	if strings.Index(dump, "CREATE INDEX") < strings.Index(dump, "CREATE TABLE users") {
+		t.Errorf("schema dump lists indexes before tables: %q", dump)
+	}
+
+	if out := mustRun(t, append([]string{"db", "ping"}, conn...)...); !strings.HasPrefix(out, "OK (sqlite") {
+		t.Errorf("db ping output = %q", out)
+	}
+
+	plan := mustRun(t, append([]string{"sql", "explain", "SELECT id FROM users WHERE email = 'a@b.c'"}, conn...)...)
+	if !strings.Contains(plan, "users_email_index") {
+		t.Errorf("sql explain output = %q", plan)
+	}
+	if _, _, code := runCLI(t, append([]string{"sql", "explain", "SELECT 1; SELECT 2"}, conn...)...); code != 2 {
+		t.Errorf("sql explain with two statements exited %d, want 2", code)
+	}
+
+	if out := mustRun(t, append([]string{"migrate", "down"}, conn...)...); !strings.Contains(out, "Rolled back:") {
+		t.Errorf("migrate down output = %q", out)
+	}
+	if dump := mustRun(t, append([]string{"schema", "dump"}, conn...)...); strings.Contains(dump, "users") {
+		t.Errorf("users table still exists after down: %q", dump)
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	clearEnv(t)
+	tests := [][]string{
+		{},
+		{"migrate"},
+		{"migrate", "sideways"},
+		{"migrate", "make"},
+		{"migrate", "make", "Bad-Name"},
+		{"migrate", "diff", "one", "two"},
+		{"-driver", "sqlite", "db", "ping"},
+		{"-steps", "-1", "migrate", "down"},
+	}
+	for _, args := range tests {
+		if _, errOut, code := runCLI(t, args...); code != 2 || !strings.Contains(errOut, "Usage:") {
+			t.Errorf("fluentsql %q exited %d, want 2 with usage; stderr = %q", args, code, errOut)
+		}
+	}
+}
+
+func TestConfigPrecedence(t *testing.T) {
+	clearEnv(t)
+	cfg := filepath.Join(t.TempDir(), "db.yaml")
+	yaml := `driver: postgresql
+host: db.internal
+database: app
+username: ${APP_DB_USER}
+password: from-file
+migrations:
+  dir: db/migrations
+  table: schema_history
+`
+	if err := os.WriteFile(cfg, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("APP_DB_USER", "deploy")
+	t.Setenv("FLUENTSQL_CONFIG", cfg)
+	t.Setenv("FLUENTSQL_PASSWORD", "from-env")
+	t.Setenv("FLUENTSQL_HOST", "env.internal")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	f := registerFlags(fs)
+	if err := fs.Parse([]string{"-host", "flag.internal"}); err != nil {
+		t.Fatal(err)
+	}
+	opts, err := loadOptions(fs, f)
+	if err != nil {
+		t.Fatalf("loadOptions() error = %v", err)
+	}
+
+	c := opts.config
+	if c.Driver != "postgres" || c.Port != 5432 || c.Database != "app" || c.Username != "deploy" {
+		t.Errorf("file settings = %+v", c)
+	}
+	if c.Password != "from-env" {
+		t.Errorf("Password = %q, want env to override the file", c.Password)
+	}
+	if c.Host != "flag.internal" {
+		t.Errorf("Host = %q, want flag to override env", c.Host)
+	}
+	if opts.migrationsDir != "db/migrations" || opts.migrationTable != "schema_history" {
+		t.Errorf("migrations = %q, %q", opts.migrationsDir, opts.migrationTable)
+	}
+
+	t.Setenv("FLUENTSQL_CONFIG", filepath.Join(t.TempDir(), "missing.yaml"))
+	if _, err := loadOptions(fs, f); err == nil {
+		t.Error("loadOptions() with a missing explicit config file should fail")
+	}
+}
+
+func TestConfigFile_LiteralDollar(t *testing.T) {
+	clearEnv(t)
+	cfg := filepath.Join(t.TempDir(), "db.yaml")
+	yaml := `driver: mysql
+username: ${APP_DB_USER}
+password: pa$$word$1
+database: "p@ss$1-${MISSING_VAR}"
+`
+	if err := os.WriteFile(cfg, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("APP_DB_USER", "deploy")
+	t.Setenv("FLUENTSQL_CONFIG", cfg)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	opts, err := loadOptions(fs, registerFlags(fs))
+	if err != nil {
+		t.Fatalf("loadOptions() error = %v", err)
+	}
+
+	c := opts.config
+	if c.Username != "deploy" {
+		t.Errorf("Username = %q, want ${APP_DB_USER} expanded", c.Username)
+	}
+	if c.Password != "pa$$word$1" {
+		t.Errorf("Password = %q, want bare $ kept", c.Password)
+	}
+	if c.Database != "p@ss$1-" {
+		t.Errorf("Database = %q, want unset ${MISSING_VAR} expanded to empty", c.Database)
+	}
+}
+
+func TestGen(t *testing.T) {
+	clearEnv(t)
+	dir := t.TempDir()
+	migrations := filepath.Join(dir, "migrations")
+	if err := os.MkdirAll(migrations, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	up := `CREATE TABLE app_user_categories (id INTEGER PRIMARY KEY, name VARCHAR(100) NOT NULL);
+CREATE TABLE app_users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	category_id INTEGER REFERENCES app_user_categories,
+	email TEXT NOT NULL,
+	avatar_url TEXT,
+	score DECIMAL(10,2),
+	verified_at DATETIME,
+	active BOOLEAN NOT NULL DEFAULT 1,
+	photo BLOB
+);`
+	if err := os.WriteFile(filepath.Join(migrations, "001_init.up.sql"), []byte(up), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	conn := []string{"-driver", "sqlite", "-database", filepath.Join(dir, "app.db"), "-dir", migrations, "-prefix", "app_"}
+	mustRun(t, append([]string{"migrate", "up"}, conn...)...)
+
+	out := filepath.Join(dir, "models_gen.go")
+	if msg := mustRun(t, append([]string{"gen", "-package", "models", "-out", out}, conn...)...); !strings.Contains(msg, "(2 tables)") {
+		t.Errorf("gen output = %q", msg)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(data)
+	flat := strings.Join(strings.Fields(src), " ") // Hizalamadan bağımsız karşılaştırma
+	for _, want := range []string{
+		"// Code generated by fluentsql gen. DO NOT EDIT.",
+		"package models",
+		"type User struct {",
+		"ID int64 `db:\"id,pk\"`",
+		"CategoryID sql.NullInt64 `db:\"category_id\"`",
+		"AvatarURL sql.NullString `db:\"avatar_url\"`",
+		"Score sql.NullString `db:\"score\"`",
+		"VerifiedAt sql.NullTime `db:\"verified_at\"`",
+		"Active bool `db:\"active\"`",
+		"Photo []byte `db:\"photo\"`",
+		"UserColumnEmail = \"email\"",
+		"func (User) TableName() string { return \"users\" }",
+		"type UserCategory struct {",
+	} {
+		if !strings.Contains(flat, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, src)
+		}
+	}
+	if strings.Contains(src, "Migration") {
+		t.Errorf("migration history table was generated:\n%s", src)
+	}
+	typeCheck(t, src)
+
+	src = mustRun(t, append([]string{"gen", "-nullable", "pointer", "-tables", "app_users"}, conn...)...)
+	if flat := strings.Join(strings.Fields(src), " "); !strings.Contains(flat, "VerifiedAt *time.Time") || strings.Contains(src, "UserCategory") {
+		t.Errorf("pointer mode output:\n%s", src)
+	}
+	typeCheck(t, src)
+
+	if _, _, code := runCLI(t, append([]string{"gen", "-nullable", "maybe"}, conn...)...); code != 2 {
+		t.Errorf("gen -nullable maybe exited %d, want 2", code)
+	}
+}
+
+func TestMigrateDiff(t *testing.T) {
+	clearEnv(t)
+	dir := t.TempDir()
+	migrations := filepath.Join(dir, "migrations")
+	models := filepath.Join(dir, "models")
+	for _, d := range []string{migrations, models} {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	up := `CREATE TABLE app_users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	email VARCHAR(255) NOT NULL,
+	score DECIMAL(10,2),
+	active BOOLEAN NOT NULL DEFAULT 1,
+	legacy TEXT
+);`
+	if err := os.WriteFile(filepath.Join(migrations, "001_init.up.sql"), []byte(up), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	conn := []string{"-driver", "sqlite", "-database", filepath.Join(dir, "app.db"), "-dir", migrations, "-prefix", "app_", "-models", models}
+	mustRun(t, append([]string{"migrate", "up"}, conn...)...)
+
+	// gen çıktısı canlı şemayla birebir aynıdır
+	mustRun(t, append([]string{"gen", "-out", filepath.Join(models, "models_gen.go")}, conn...)...)
+	if out := mustRun(t, append([]string{"migrate", "diff"}, conn...)...); out != "Schema is up to date.\n" {
+		t.Fatalf("migrate diff after gen = %q", out)
+	}
+
+	src := `package models
+
+import "database/sql"
+
+type User struct {
+	ID     int64          ` + "`db:\"id,pk\"`" + `
+	Email  string         ` + "`db:\"email\"`" + `
+	Score  sql.NullString ` + "`db:\"score\"`" + `
+	Active bool
+	Phone  *string        ` + "`db:\"phone\"`" + `
+}
+
+func (User) TableName() string { return "users" }
+
+type Tag struct {
+	ID   int64  ` + "`db:\"id,pk\"`" + `
+	Name string ` + "`db:\"name\"`" + `
+	note string
+}
+
+func (*Tag) TableName() string { return "tags" }
+`
+	if err := os.Remove(filepath.Join(models, "models_gen.go")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(models, "models.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := mustRun(t, append([]string{"migrate", "diff"}, conn...)...)
+	for _, want := range []string{
+		"-- app_tags\nCREATE TABLE \"app_tags\" (\"id\" INTEGER PRIMARY KEY AUTOINCREMENT, \"name\" VARCHAR(255) NOT NULL);\n",
+		"-- app_users\nALTER TABLE \"app_users\" ADD COLUMN \"phone\" VARCHAR(255);\n",
+		"-- Skipped, rerun with -allow-drops or -allow-lossy to apply:\n-- ALTER TABLE \"app_users\" DROP COLUMN \"legacy\";\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("migrate diff output does not contain %q:\n%s", want, out)
+		}
+	}
+	if out := mustRun(t, append([]string{"migrate", "diff", "-allow-drops"}, conn...)...); !strings.Contains(out, "\nALTER TABLE \"app_users\" DROP COLUMN \"legacy\";\n") {
+		t.Errorf("migrate diff -allow-drops output = %q", out)
+	}
+
+	// Migration olarak yazılır; silme ifadesi yorum olarak kalır
+	if out := mustRun(t, append([]string{"migrate", "diff", "add_phone_and_tags"}, conn...)...); strings.Count(out, "Created:") != 2 {
+		t.Fatalf("migrate diff add_phone_and_tags output = %q", out)
+	}
+	mustRun(t, append([]string{"migrate", "up"}, conn...)...)
+	out = mustRun(t, append([]string{"migrate", "diff"}, conn...)...)
+	if strings.Contains(out, "phone") || strings.Contains(out, "app_tags") || !strings.Contains(out, "-- ALTER TABLE \"app_users\" DROP COLUMN \"legacy\";") {
+		t.Errorf("migrate diff after applying = %q", out)
+	}
+}
+
+// typeCheck, üretilen kaynağın derlendiğini doğrular.
+func typeCheck(t *testing.T, src string) {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "models_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse generated code: %v", err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("models", fset, []*ast.File{file}, nil); err != nil {
+		t.Errorf("generated code does not compile: %v\n%s", err, src)
+	}
+}
+
+func TestGoNames(t *testing.T) {
+	tests := map[string]string{
+		"users":           "User",
+		"user_categories": "UserCategory",
+		"addresses":       "Address",
+		"boxes":           "Box",
+		"status":          "Status",
+		"api_keys":        "APIKey",
+		"2fa_codes":       "X2faCode",
+	}
+	for table, want := range tests {
+		if got := goName(singular(table)); got != want {
+			t.Errorf("goName(singular(%q)) = %q, want %q", table, got, want)
+		}
+	}
+}
@@ -8,6 +8,8 @@
 // Email: ahmet.altun60@gmail.com
 package dialect
 
+import "strings"
+
 // ----------------------------------------------------------------------------
 // QueryBuilder Interface (import döngüsünü kırmak için)
 // ----------------------------------------------------------------------------
@@ -26,6 +28,8 @@ type QueryBuilder interface {
 	GetHaving() []WhereClause
 	GetLimit() *int
 	GetOffset() *int
+	GetLock() *LockClause
+	GetReturning() []string
 }
 
 // ----------------------------------------------------------------------------
@@ -53,6 +57,22 @@ type Grammar interface {
 	Placeholder(index int) string
 
 	// CompileSelect, SELECT sorgusunu derler.
+	//
+	// Tam metin arama koşulları (WhereTypeFullText, OrderClause.FullText)
+	// veritabanının kendi arama sözdizimine çevrilir: MySQL'de
+	// MATCH(...) AGAINST(...), PostgreSQL'de to_tsvector(...) @@
+	// plainto_tsquery(?), SQLite'ta FTS5 "col MATCH ?". Karşılığı olmayan
+	// arama seçenekleri (PostgreSQL ve SQLite'ta sorgu genişletme) başka bir
+	// veritabanının sözdizimine düşmek yerine ErrUnsupportedFeature
+	// döndürmelidir.
+	//
+	// GetLock ile gelen satır kilidi veritabanının sözdizimine çevrilir:
+	// MySQL ve PostgreSQL'de SELECT sonuna "FOR UPDATE/FOR SHARE [OF ...]
+	// [SKIP LOCKED | NOWAIT]", MariaDB'de "FOR UPDATE" / "LOCK IN SHARE MODE".
+	// Satır kilidi olmayan SQLite'ta kilit yok sayılır; SQL Server gramerleri
+	// kilidi tablo ipucu olarak "WITH (UPDLOCK, ROWLOCK)" biçiminde yazar.
+	// Veritabanında bulunan ama istenen seçeneği (ör. MariaDB'de OF) ifade
+	// edemeyen gramerler ErrUnsupportedFeature döndürmelidir.
 	CompileSelect(b QueryBuilder) (string, []any, error)
 
 	// CompileInsert, INSERT sorgusunu derler.
@@ -62,9 +82,18 @@ type Grammar interface {
 	CompileInsertBatch(b QueryBuilder, data []map[string]any) (string, []any, error)
 
 	// CompileUpdate, UPDATE sorgusunu derler.
+	//
+	// GetJoins boş değilse ifade başka tablolara bağlı bir UPDATE'tir
+	// (Join / UpdateFrom). MySQL bunu "UPDATE a INNER JOIN b ON ... SET ..."
+	// olarak yazar; PostgreSQL ve SQLite karşılığı "UPDATE a SET ... FROM b
+	// WHERE ..." biçimidir. Bağlı UPDATE yazamayan gramerler
+	// ErrUnsupportedFeature döndürmelidir.
 	CompileUpdate(b QueryBuilder, data map[string]any) (string, []any, error)
 
-	// CompileDelete, DELETE sorgusunu derler.
+	// CompileDelete, DELETE sorgusunu derler. JOIN içeren builder'larda
+	// yalnızca ana tablonun satırları silinir: MySQL'de "DELETE a FROM a
+	// INNER JOIN b ...", PostgreSQL'de "DELETE FROM a USING b WHERE ...".
+	// DELETE'te JOIN yazamayan gramerler ErrJoinNotSupported döndürür.
 	CompileDelete(b QueryBuilder) (string, []any, error)
 
 	// CompileExists, EXISTS alt sorgusunu derler.
@@ -85,6 +114,27 @@ type Grammar interface {
 	// SupportsReturning, gramerin RETURNING cümlesini destekleyip desteklemediğini döndürür.
 	SupportsReturning() bool
 
+	// CompileExplain, verilen sorguyu makine tarafından okunabilir (JSON) bir
+	// çalışma planı döndüren EXPLAIN ifadesine çevirir. EXPLAIN edilemeyen
+	// ifadeler için ErrExplainNotSupported döner.
+	CompileExplain(query string) (string, error)
+
+	// CompileSavepoint, verilen adla savepoint oluşturan ifadeyi derler.
+	CompileSavepoint(name string) (string, error)
+
+	// CompileRollbackToSavepoint, savepoint'e geri dönen ifadeyi derler.
+	CompileRollbackToSavepoint(name string) (string, error)
+
+	// CompileReleaseSavepoint, savepoint'i serbest bırakan ifadeyi derler.
+	// Serbest bırakmayı desteklemeyen veritabanları (SQL Server) için boş
+	// string döner; bu durumda çalıştırılacak bir ifade yoktur.
+	CompileReleaseSavepoint(name string) (string, error)
+
+	// ClassifyError, sürücü hatasının kodlarını ve mesajını veritabanından
+	// bağımsız bir kategoriye (unique, foreign key, deadlock ...) çevirir.
+	// Tanınmayan hatalar için Kind ErrorKindUnknown olur.
+	ClassifyError(code ErrorCode) ErrorClassification
+
 	// DateFormat, veritabanı için tarih formatını döndürür.
 	DateFormat() string
 }
@@ -118,6 +168,44 @@ func (g *BaseGrammar) SupportsReturning() bool {
 	return false
 }
 
+// ClassifyError, hatayı yalnızca standart SQLSTATE değerine göre sınıflandırır.
+// Sürücüye özgü numaraları ve mesajları tanıyan gramerler bunu geçersiz kılar.
+func (g *BaseGrammar) ClassifyError(code ErrorCode) ErrorClassification {
+	return ErrorClassification{Kind: sqlStateKind(code.SQLState)}
+}
+
+// ForDriver, database/sql sürücü adına karşılık gelen grameri döndürür.
+// Tanınan adlar: mysql, mariadb, postgres, postgresql, pgx, pgx/v5, sqlite,
+// sqlite3. Tanınmayan adlar için ikinci değer false olur.
+func ForDriver(driverName string) (Grammar, bool) {
+	switch strings.ToLower(driverName) {
+	case "mysql":
+		return MySQL(), true
+	case "mariadb":
+		return MariaDB(), true
+	case "postgres", "postgresql", "pgx", "pgx/v5":
+		return Postgres(), true
+	case "sqlite", "sqlite3":
+		return SQLite(), true
+	default:
+		return nil, false
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Expression Interface
+// ----------------------------------------------------------------------------
+
+// Expression, INSERT/UPDATE değer haritalarında placeholder yerine SQL'e
+// doğrudan gömülecek ifadeleri temsil eder (örn. "views = views + ?").
+//
+// wrap fonksiyonu, ifadenin içindeki kolon adlarını gramerin tırnaklama
+// kurallarıyla sarmak için verilir. Dönen bağlamalar, ifadenin SQL içindeki
+// konumuna göre argüman listesine eklenir.
+type Expression interface {
+	CompileExpression(wrap func(identifier string) (string, error)) (string, []any, error)
+}
+
 // ----------------------------------------------------------------------------
 // WHERE Clause Types
 // ----------------------------------------------------------------------------
@@ -139,6 +227,7 @@ const (
 	WhereTypeYear
 	WhereTypeMonth
 	WhereTypeDay
+	WhereTypeFullText
 )
 
 // String, WhereType'ın string temsilini döndürür.
@@ -146,7 +235,7 @@ func (t WhereType) String() string {
 	names := [...]string{
 		"Basic", "In", "NotIn", "Between", "NotBetween",
 		"Null", "NotNull", "Raw", "Nested",
-		"Date", "Year", "Month", "Day",
+		"Date", "Year", "Month", "Day", "FullText",
 	}
 	if int(t) < len(names) {
 		return names[t]
@@ -172,15 +261,16 @@ func (b WhereBoolean) String() string {
 
 // WhereClause, tek bir WHERE koşulunu temsil eder.
 type WhereClause struct {
-	Type     WhereType     // Koşul türü
-	Boolean  WhereBoolean  // AND/OR bağlacı
-	Column   string        // Sütun adı
-	Operator string        // Operatör (örn. "=", "IN")
-	Value    any           // Tek değer
-	Values   []any         // IN, BETWEEN gibi çoklu değerler
-	Nested   []WhereClause // İç içe koşullar
-	Raw      string        // Raw SQL ifadesi (dikkatli kullanın)
-	Bindings []any         // Raw SQL bağlamaları
+	Type     WhereType       // Koşul türü
+	Boolean  WhereBoolean    // AND/OR bağlacı
+	Column   string          // Sütun adı
+	Operator string          // Operatör (örn. "=", "IN")
+	Value    any             // Tek değer
+	Values   []any           // IN, BETWEEN gibi çoklu değerler
+	Nested   []WhereClause   // İç içe koşullar
+	Raw      string          // Raw SQL ifadesi (dikkatli kullanın)
+	Bindings []any           // Raw SQL bağlamaları
+	FullText *FullTextClause // Tam metin arama koşulu
 }
 
 // ----------------------------------------------------------------------------
@@ -204,7 +294,85 @@ func (d OrderDirection) IsValid() bool {
 type OrderClause struct {
 	Column    string
 	Direction OrderDirection
-	Raw       string // Raw ifade (dikkatli kullanın)
+	Raw       string          // Raw ifade (dikkatli kullanın)
+	FullText  *FullTextClause // Alaka düzeyine göre sıralama (OrderByRelevance)
+}
+
+// ----------------------------------------------------------------------------
+// Row Locking Types
+// ----------------------------------------------------------------------------
+
+// LockStrength, SELECT sorgusuna eklenecek satır kilidinin türünü belirtir.
+type LockStrength string
+
+const (
+	// LockUpdate, okunan satırları yazma için kilitler (FOR UPDATE).
+	LockUpdate LockStrength = "UPDATE"
+	// LockShare, okunan satırları paylaşımlı olarak kilitler (FOR SHARE).
+	LockShare LockStrength = "SHARE"
+)
+
+// LockWait, kilitli satırlarla karşılaşıldığında nasıl davranılacağını belirtir.
+type LockWait string
+
+const (
+	// LockWaitDefault, kilit serbest kalana kadar bekler.
+	LockWaitDefault LockWait = ""
+	// LockSkipLocked, kilitli satırları atlar (iş kuyrukları için idealdir).
+	LockSkipLocked LockWait = "SKIP LOCKED"
+	// LockNoWait, kilitli satırla karşılaşıldığında beklemeden hata döndürür.
+	LockNoWait LockWait = "NOWAIT"
+)
+
+// LockClause, SELECT sorgusuna eklenecek kilit ifadesini temsil eder.
+// Gramerler bunu kendi sözdizimlerine çevirir: satır kilidi olmayan SQLite'ta
+// kilit yok sayılır, SQL Server'da "WITH (UPDLOCK, ROWLOCK)" tablo ipucuna
+// dönüşür. Kilidi destekleyen ama istenen seçeneği ifade edemeyen
+// veritabanlarında ErrUnsupportedFeature döner.
+type LockClause struct {
+	Strength LockStrength // FOR UPDATE / FOR SHARE
+	Wait     LockWait     // SKIP LOCKED / NOWAIT
+	Of       []string     // Yalnızca belirtilen tabloların satırlarını kilitle
+}
+
+// ----------------------------------------------------------------------------
+// Full-Text Search Types
+// ----------------------------------------------------------------------------
+
+// FullTextMode, tam metin aramanın hangi modda çalışacağını belirtir.
+type FullTextMode string
+
+const (
+	// FullTextBoolean, +kelime -kelime "ifade" gibi operatörlere izin veren moddur (varsayılan).
+	FullTextBoolean FullTextMode = "BOOLEAN"
+	// FullTextNatural, arama metnini doğal dil olarak yorumlar.
+	FullTextNatural FullTextMode = "NATURAL"
+	// FullTextExpansion, doğal dil aramasını sorgu genişletme ile birlikte çalıştırır.
+	FullTextExpansion FullTextMode = "EXPANSION"
+)
+
+// IsValid, modun tanınan bir değer olup olmadığını kontrol eder.
+// Boş mod geçerlidir ve FullTextBoolean olarak yorumlanır.
+func (m FullTextMode) IsValid() bool {
+	switch m {
+	case "", FullTextBoolean, FullTextNatural, FullTextExpansion:
+		return true
+	default:
+		return false
+	}
+}
+
+// FullTextOptions, tam metin aramanın davranışını belirleyen ayarlardır.
+type FullTextOptions struct {
+	Mode FullTextMode // Arama modu (boşsa FullTextBoolean)
+}
+
+// FullTextClause, tam metin arama ifadesini temsil eder.
+// Aynı ifade hem WHERE koşulunda hem de ORDER BY alaka sıralamasında kullanılır.
+type FullTextClause struct {
+	Columns []string        // Aranacak sütunlar
+	Query   string          // Arama metni (her zaman parametre olarak bağlanır)
+	Options FullTextOptions // Arama ayarları
 }
 
 // ----------------------------------------------------------------------------
@@ -225,10 +393,11 @@ const (
 type JoinClause struct {
 	Type     JoinType
 	Table    string
-	Alias    string // Opsiyonel tablo alias
-	First    string // Sol sütun
-	Operator string // Genellikle "="
-	Second   string // Sağ sütun
+	Alias    string       // Opsiyonel tablo alias (alt sorgu JOIN'lerinde zorunlu)
+	First    string       // Sol sütun
+	Operator string       // Genellikle "="
+	Second   string       // Sağ sütun
+	Subquery QueryBuilder // Tablo yerine JOIN edilecek alt sorgu (JoinSub / UpdateFrom)
 }
 
 // ----------------------------------------------------------------------------
@@ -238,12 +407,24 @@ type JoinClause struct {
 // Dialect implementasyonları için ortak hatalar.
 // Ana paket ile import döngüsünü önlemek için burada tanımlanmıştır.
 var (
-	ErrNoTable           = &DialectError{Message: "no table specified"}
-	ErrNoColumns         = &DialectError{Message: "no columns specified"}
-	ErrEmptyBatch        = &DialectError{Message: "cannot insert empty batch"}
-	ErrInconsistentBatch = &DialectError{Message: "inconsistent columns in batch"}
-	ErrEmptyWhereIn      = &DialectError{Message: "empty slice passed to WhereIn"}
-	ErrInvalidBetween    = &DialectError{Message: "BETWEEN requires exactly 2 values"}
+	ErrNoTable               = &DialectError{Message: "no table specified"}
+	ErrNoColumns             = &DialectError{Message: "no columns specified"}
+	ErrEmptyBatch            = &DialectError{Message: "cannot insert empty batch"}
+	ErrInconsistentBatch     = &DialectError{Message: "inconsistent columns in batch"}
+	ErrEmptyWhereIn          = &DialectError{Message: "empty slice passed to WhereIn"}
+	ErrInvalidBetween        = &DialectError{Message: "BETWEEN requires exactly 2 values"}
+	ErrInvalidFullText       = &DialectError{Message: "invalid full-text search mode"}
+	ErrInvalidLock           = &DialectError{Message: "invalid lock clause"}
+	ErrSubqueryAlias         = &DialectError{Message: "subquery join requires an alias"}
+	ErrJoinNotSupported      = &DialectError{Message: "joins are not supported for this statement"}
+	ErrReturningNotSupported = &DialectError{Message: "RETURNING is not supported for this statement by the dialect"}
+	ErrExplainNotSupported   = &DialectError{Message: "EXPLAIN is not supported for this statement"}
+
+	// ErrUnsupportedFeature, istenen özelliğin (tam metin arama, satır kilidi
+	// seçeneği, bağlı UPDATE vb.) gramerde karşılığı olmadığında döner.
+	// Yeni gramerler başka bir veritabanının sözdizimini üretmek yerine bu
+	// hatayı döndürmelidir.
+	ErrUnsupportedFeature = &DialectError{Message: "feature is not supported by the dialect"}
 )
 
 // DialectError, dialect'e özgü hataları temsil eder.
@@ -255,3 +436,76 @@ type DialectError struct {
 func (e *DialectError) Error() string {
 	return "dialect: " + e.Message
 }
+
+// ----------------------------------------------------------------------------
+// Driver Error Classification
+// ----------------------------------------------------------------------------
+
+// ErrorKind, sürücü hatasının veritabanından bağımsız kategorisidir.
+type ErrorKind int
+
+const (
+	ErrorKindUnknown ErrorKind = iota
+	ErrorKindUniqueViolation
+	ErrorKindForeignKeyViolation
+	ErrorKindNotNullViolation
+	ErrorKindCheckViolation
+	ErrorKindDeadlock
+	ErrorKindLockTimeout
+	ErrorKindSerialization
+	ErrorKindConnection
+)
+
+// String, ErrorKind'ın string temsilini döndürür.
+func (k ErrorKind) String() string {
+	names := [...]string{
+		"Unknown", "UniqueViolation", "ForeignKeyViolation", "NotNullViolation",
+		"CheckViolation", "Deadlock", "LockTimeout", "Serialization", "Connection",
+	}
+	if int(k) < len(names) {
+		return names[k]
+	}
+	return "Unknown"
+}
+
+// ErrorCode, sürücü hatasından okunan ham bilgidir.
+type ErrorCode struct {
+	Number   int    // Sürücüye özgü hata numarası (MySQL: 1062, 1213 ...; yoksa 0)
+	SQLState string // Beş karakterlik SQLSTATE (yoksa boş)
+	Message  string // Sürücünün hata mesajı
+}
+
+// ErrorClassification, ClassifyError'ın sonucudur. Constraint ve Column,
+// veritabanı mesajında yer aldığında doldurulur.
+type ErrorClassification struct {
+	Kind       ErrorKind
+	Constraint string // İhlal edilen constraint veya index adı
+	Column     string // İhlale neden olan sütun
+}
+
+// sqlStateKind, standart SQLSTATE değerlerini kategoriye çevirir.
+func sqlStateKind(state string) ErrorKind {
+	switch state {
+	case "23505":
+		return ErrorKindUniqueViolation
+	case "23503":
+		return ErrorKindForeignKeyViolation
+	case "23502":
+		return ErrorKindNotNullViolation
+	case "23514":
+		return ErrorKindCheckViolation
+	case "40P01":
+		return ErrorKindDeadlock
+	case "55P03":
+		return ErrorKindLockTimeout
+	case "40001":
+		return ErrorKindSerialization
+	case "57P01", "57P02", "57P03":
+		return ErrorKindConnection
+	}
+	// 08xxx: bağlantı istisnaları sınıfı
+	if len(state) == 5 && state[:2] == "08" {
+		return ErrorKindConnection
+	}
+	return ErrorKindUnknown
+}
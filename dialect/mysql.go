@@ -2,6 +2,7 @@ package dialect
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -41,8 +42,25 @@ import (
 // MySQL'e özgü davranışları (parametre yer tutucuları, tırnaklama stili vb.) ekler.
 type MySQLGrammar struct {
 	BaseGrammar
+
+	// returning, INSERT/DELETE ... RETURNING desteğini açar (MariaDB 10.5+).
+	returning bool
+
+	// engine, ortak derleme hattında veritabanına özgü dalları (tırnaklama,
+	// kilitler, tam metin arama ...) seçer. Sıfır değeri MySQL'dir.
+	engine engine
 }
 
+// engine, MySQLGrammar derleme hattını paylaşan veritabanlarını ayırt eder.
+type engine int
+
+const (
+	engineMySQL engine = iota
+	engineMariaDB
+	enginePostgres
+	engineSQLite
+)
+
 // MySQL, yeni bir MySQL dilbilgisi örneği oluşturur.
 //
 // Varsayılan tarih formatı ve sürücü isimlendirmesi burada yapılandırılır.
@@ -56,6 +74,28 @@ func MySQL() *MySQLGrammar {
 	}
 }
 
+// MariaDB, MariaDB 10.5+ için MySQL gramerinin bir türevini oluşturur.
+//
+// MySQL ile aynı sözdizimini kullanır; ek olarak INSERT ve DELETE
+// ifadelerinde RETURNING cümlesini destekler. MariaDB, UPDATE ... RETURNING
+// desteklemediği için UPDATE sorgularında RETURNING hata döndürür.
+func MariaDB() *MySQLGrammar {
+	return &MySQLGrammar{
+		BaseGrammar: BaseGrammar{
+			name:       "mariadb",
+			dateFormat: "2006-01-02 15:04:05",
+		},
+		returning: true,
+		engine:    engineMariaDB,
+	}
+}
+
+// SupportsReturning, gramerin RETURNING cümlesini destekleyip desteklemediğini döndürür.
+// MySQL için false, MariaDB için true döner.
+func (g *MySQLGrammar) SupportsReturning() bool {
+	return g.returning
+}
+
 // NewMySQLGrammar, geriye dönük uyumluluk (backward compatibility) için
 // MySQL() kurucusuna (constructor) verilen bir takma addır.
 func NewMySQLGrammar() *MySQLGrammar {
@@ -85,12 +125,24 @@ func (g *MySQLGrammar) Wrap(identifier string) (string, error) {
 		parts := strings.Split(identifier, ".")
 		wrapped := make([]string, len(parts))
 		for i, part := range parts {
-			wrapped[i] = "`" + part + "`"
+			wrapped[i] = g.quote(part)
 		}
 		return strings.Join(wrapped, "."), nil
 	}
 
-	return "`" + identifier + "`", nil
+	return g.quote(identifier), nil
+}
+
+// quote, doğrulanmış tek bir tanımlayıcı parçasını gramerin tırnak
+// karakteriyle sarar: MySQL/MariaDB'de backtick, PostgreSQL ve SQLite'ta
+// standart çift tırnak.
+func (g *MySQLGrammar) quote(part string) string {
+	switch g.engine {
+	case enginePostgres, engineSQLite:
+		return `"` + part + `"`
+	default:
+		return "`" + part + "`"
+	}
 }
 
 // WrapTable, tablo ismini ve varsa takma adını (alias) güvenli bir şekilde sarmalar.
@@ -103,9 +155,9 @@ func (g *MySQLGrammar) WrapTable(table string) (string, error) {
 		return "", err
 	}
 
-	wrapped := "`" + name + "`"
+	wrapped := g.quote(name)
 	if alias != "" {
-		wrapped += " AS `" + alias + "`"
+		wrapped += " AS " + g.quote(alias)
 	}
 
 	return wrapped, nil
@@ -176,15 +228,12 @@ func (g *MySQLGrammar) CompileSelect(b QueryBuilder) (string, []any, error) {
 	sql.WriteString(table)
 
 	// JOIN
-	joins := b.GetJoins()
-	for _, join := range joins {
-		joinSQL, err := g.compileJoin(join)
-		if err != nil {
-			return "", nil, err
-		}
-		sql.WriteString(" ")
-		sql.WriteString(joinSQL)
+	joinSQL, joinArgs, err := g.compileJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
 	}
+	sql.WriteString(joinSQL)
+	args = append(args, joinArgs...)
 
 	// WHERE
 	wheres := b.GetWheres()
@@ -231,7 +280,14 @@ func (g *MySQLGrammar) CompileSelect(b QueryBuilder) (string, []any, error) {
 		sql.WriteString(" ORDER BY ")
 		orderParts := make([]string, len(orders))
 		for i, order := range orders {
-			if order.Raw != "" {
+			if order.FullText != nil {
+				matchSQL, matchArgs, err := g.compileRelevance(*order.FullText)
+				if err != nil {
+					return "", nil, err
+				}
+				orderParts[i] = matchSQL + " " + string(order.Direction)
+				args = append(args, matchArgs...)
+			} else if order.Raw != "" {
 				orderParts[i] = order.Raw
 			} else {
 				wrapped, err := g.Wrap(order.Column)
@@ -247,6 +303,9 @@ func (g *MySQLGrammar) CompileSelect(b QueryBuilder) (string, []any, error) {
 	// LIMIT
 	if limit := b.GetLimit(); limit != nil {
 		sql.WriteString(fmt.Sprintf(" LIMIT %d", *limit))
+	} else if b.GetOffset() != nil && g.engine == engineSQLite {
+		// SQLite OFFSET'i yalnızca LIMIT ile birlikte kabul eder
+		sql.WriteString(" LIMIT -1")
 	}
 
 	// OFFSET
@@ -254,6 +313,15 @@ func (g *MySQLGrammar) CompileSelect(b QueryBuilder) (string, []any, error) {
 		sql.WriteString(fmt.Sprintf(" OFFSET %d", *offset))
 	}
 
+	// FOR UPDATE / FOR SHARE
+	if lock := b.GetLock(); lock != nil {
+		lockSQL, err := g.compileLock(*lock)
+		if err != nil {
+			return "", nil, err
+		}
+		sql.WriteString(lockSQL)
+	}
+
 	return sql.String(), args, nil
 }
 
@@ -262,6 +330,22 @@ func (g *MySQLGrammar) CompileSelect(b QueryBuilder) (string, []any, error) {
 // Map yapısındaki veriyi alır, anahtarları alfabetik sıralar (deterministik test edilebilirlik için)
 // ve "INSERT INTO table (col1, col2) VALUES (?, ?)" formatında hazırlar.
 func (g *MySQLGrammar) CompileInsert(b QueryBuilder, data map[string]any) (string, []any, error) {
+	sql, args, err := g.compileInsert(b, data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	returning, err := g.compileReturning(b, "insert")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql + returning, args, nil
+}
+
+// compileInsert, RETURNING içermeyen çıplak INSERT ifadesini derler.
+// CompileInsert ve CompileUpsert tarafından ortak kullanılır.
+func (g *MySQLGrammar) compileInsert(b QueryBuilder, data map[string]any) (string, []any, error) {
 	if b.GetTable() == "" {
 		return "", nil, ErrNoTable
 	}
@@ -290,23 +374,26 @@ func (g *MySQLGrammar) CompileInsert(b QueryBuilder, data map[string]any) (strin
 	// Columns
 	sql.WriteString(" (")
 	wrappedCols := make([]string, len(keys))
+	placeholders := make([]string, len(keys))
 	for i, key := range keys {
 		wrapped, err := g.Wrap(key)
 		if err != nil {
 			return "", nil, err
 		}
 		wrappedCols[i] = wrapped
-		args = append(args, data[key])
+
+		valueSQL, valueArgs, err := g.compileValue(data[key], len(args))
+		if err != nil {
+			return "", nil, err
+		}
+		placeholders[i] = valueSQL
+		args = append(args, valueArgs...)
 	}
 	sql.WriteString(strings.Join(wrappedCols, ", "))
 	sql.WriteString(")")
 
 	// VALUES
 	sql.WriteString(" VALUES (")
-	placeholders := make([]string, len(keys))
-	for i := range keys {
-		placeholders[i] = g.Placeholder(i)
-	}
 	sql.WriteString(strings.Join(placeholders, ", "))
 	sql.WriteString(")")
 
@@ -378,20 +465,32 @@ func (g *MySQLGrammar) CompileInsertBatch(b QueryBuilder, data []map[string]any)
 			if !ok {
 				return "", nil, ErrInconsistentBatch
 			}
-			placeholders[j] = g.Placeholder(len(args))
-			args = append(args, val)
+			valueSQL, valueArgs, err := g.compileValue(val, len(args))
+			if err != nil {
+				return "", nil, err
+			}
+			placeholders[j] = valueSQL
+			args = append(args, valueArgs...)
 		}
 		rowPlaceholders[i] = "(" + strings.Join(placeholders, ", ") + ")"
 	}
 	sql.WriteString(strings.Join(rowPlaceholders, ", "))
 
+	returning, err := g.compileReturning(b, "insert")
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(returning)
+
 	return sql.String(), args, nil
 }
 
 // CompileUpdate, mevcut kayıtları güncellemek için UPDATE sorgusu oluşturur.
 //
 // SET bloğunu oluştururken, parametrik yapı (prepared statements) kullanılarak
-// SQL Injection riski elimine edilir.
+// SQL Injection riski elimine edilir. JOIN içeren builder'lar MySQL'in çok
+// tablolu UPDATE sözdizimiyle derlenir: "UPDATE a INNER JOIN b ON ... SET ...".
+// JOIN'ler asla sessizce düşürülmez; aksi halde güncelleme tüm tabloya yayılırdı.
 func (g *MySQLGrammar) CompileUpdate(b QueryBuilder, data map[string]any) (string, []any, error) {
 	if b.GetTable() == "" {
 		return "", nil, ErrNoTable
@@ -399,6 +498,9 @@ func (g *MySQLGrammar) CompileUpdate(b QueryBuilder, data map[string]any) (strin
 	if len(data) == 0 {
 		return "", nil, ErrNoColumns
 	}
+	if len(b.GetJoins()) > 0 && (g.engine == enginePostgres || g.engine == engineSQLite) {
+		return g.compileUpdateFrom(b, data)
+	}
 
 	var sql strings.Builder
 	args := make([]any, 0)
@@ -418,6 +520,14 @@ func (g *MySQLGrammar) CompileUpdate(b QueryBuilder, data map[string]any) (strin
 	sql.WriteString("UPDATE ")
 	sql.WriteString(table)
 
+	// JOIN (multi-table UPDATE)
+	joinSQL, joinArgs, err := g.compileJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(joinSQL)
+	args = append(args, joinArgs...)
+
 	// SET
 	sql.WriteString(" SET ")
 	setParts := make([]string, len(keys))
@@ -426,8 +536,12 @@ func (g *MySQLGrammar) CompileUpdate(b QueryBuilder, data map[string]any) (strin
 		if err != nil {
 			return "", nil, err
 		}
-		setParts[i] = wrapped + " = " + g.Placeholder(i)
-		args = append(args, data[key])
+		valueSQL, valueArgs, err := g.compileValue(data[key], len(args))
+		if err != nil {
+			return "", nil, err
+		}
+		setParts[i] = wrapped + " = " + valueSQL
+		args = append(args, valueArgs...)
 	}
 	sql.WriteString(strings.Join(setParts, ", "))
 
@@ -443,13 +557,21 @@ func (g *MySQLGrammar) CompileUpdate(b QueryBuilder, data map[string]any) (strin
 		args = append(args, whereArgs...)
 	}
 
+	returning, err := g.compileReturning(b, "update")
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(returning)
+
 	return sql.String(), args, nil
 }
 
 // CompileDelete, kayıt silme sorgusu (DELETE) oluşturur.
 //
 // WHERE koşulları eklenerek, tüm tablonun yanlışlıkla silinmesi (truncate etkisi)
-// engellenir (tabii geliştirici WHERE eklemeyi unutmazsa).
+// engellenir (tabii geliştirici WHERE eklemeyi unutmazsa). JOIN içeren
+// builder'larda yalnızca ana tablonun satırları silinir:
+// "DELETE `a` FROM `a` INNER JOIN `b` ON ...".
 func (g *MySQLGrammar) CompileDelete(b QueryBuilder) (string, []any, error) {
 	if b.GetTable() == "" {
 		return "", nil, ErrNoTable
@@ -463,8 +585,40 @@ func (g *MySQLGrammar) CompileDelete(b QueryBuilder) (string, []any, error) {
 	if err != nil {
 		return "", nil, err
 	}
-	sql.WriteString("DELETE FROM ")
-	sql.WriteString(table)
+
+	joins := b.GetJoins()
+	if len(joins) > 0 && g.engine == enginePostgres {
+		return g.compileDeleteUsing(b)
+	}
+	if len(joins) > 0 {
+		// Silinecek hedef: alias varsa alias, yoksa tablo adı
+		name, alias, err := validation.ValidateTableWithAlias(b.GetTable())
+		if err != nil {
+			return "", nil, err
+		}
+		if alias != "" {
+			name = alias
+		}
+		target, err := g.Wrap(name)
+		if err != nil {
+			return "", nil, err
+		}
+
+		joinSQL, joinArgs, err := g.compileJoins(joins)
+		if err != nil {
+			return "", nil, err
+		}
+
+		sql.WriteString("DELETE ")
+		sql.WriteString(target)
+		sql.WriteString(" FROM ")
+		sql.WriteString(table)
+		sql.WriteString(joinSQL)
+		args = append(args, joinArgs...)
+	} else {
+		sql.WriteString("DELETE FROM ")
+		sql.WriteString(table)
+	}
 
 	// WHERE
 	wheres := b.GetWheres()
@@ -478,6 +632,12 @@ func (g *MySQLGrammar) CompileDelete(b QueryBuilder) (string, []any, error) {
 		args = append(args, whereArgs...)
 	}
 
+	returning, err := g.compileReturning(b, "delete")
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(returning)
+
 	return sql.String(), args, nil
 }
 
@@ -501,6 +661,14 @@ func (g *MySQLGrammar) CompileExists(b QueryBuilder) (string, []any, error) {
 	sql.WriteString("SELECT EXISTS(SELECT 1 FROM ")
 	sql.WriteString(table)
 
+	// JOIN
+	joinSQL, joinArgs, err := g.compileJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(joinSQL)
+	args = append(args, joinArgs...)
+
 	// WHERE
 	wheres := b.GetWheres()
 	if len(wheres) > 0 {
@@ -550,6 +718,14 @@ func (g *MySQLGrammar) CompileCount(b QueryBuilder, column string) (string, []an
 	sql.WriteString(" FROM ")
 	sql.WriteString(table)
 
+	// JOIN
+	joinSQL, joinArgs, err := g.compileJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(joinSQL)
+	args = append(args, joinArgs...)
+
 	// WHERE
 	wheres := b.GetWheres()
 	if len(wheres) > 0 {
@@ -594,6 +770,14 @@ func (g *MySQLGrammar) CompileAggregate(b QueryBuilder, fn, column string) (stri
 	sql.WriteString(") FROM ")
 	sql.WriteString(table)
 
+	// JOIN
+	joinSQL, joinArgs, err := g.compileJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(joinSQL)
+	args = append(args, joinArgs...)
+
 	// WHERE
 	wheres := b.GetWheres()
 	if len(wheres) > 0 {
@@ -613,10 +797,14 @@ func (g *MySQLGrammar) CompileAggregate(b QueryBuilder, fn, column string) (stri
 //
 // DELETE FROM'dan farklı olarak, TRUNCATE DDL (Data Definition Language) komutudur
 // ve genellikle geri alınamaz (transaction-safe değildir). Auto-increment sayacını sıfırlar.
+// JOIN içeren builder'lar reddedilir; TRUNCATE kapsamı daraltılamaz.
 func (g *MySQLGrammar) CompileTruncate(b QueryBuilder) (string, error) {
 	if b.GetTable() == "" {
 		return "", ErrNoTable
 	}
+	if len(b.GetJoins()) > 0 {
+		return "", ErrJoinNotSupported
+	}
 
 	table, err := g.WrapTable(b.GetTable())
 	if err != nil {
@@ -626,13 +814,154 @@ func (g *MySQLGrammar) CompileTruncate(b QueryBuilder) (string, error) {
 	return "TRUNCATE TABLE " + table, nil
 }
 
+// CompileExplain, sorguyu "EXPLAIN FORMAT=JSON" ile sarar. MySQL 5.6+ ve
+// MariaDB 10.1+ planı tek satırlık bir JSON belgesi olarak döndürür.
+//
+// Yalnızca SELECT, INSERT, UPDATE, DELETE, REPLACE, TABLE ve WITH ifadeleri
+// EXPLAIN edilebilir; baştaki /* ... */ yorumları (ör. interceptor etiketleri)
+// atlanarak ifade türü belirlenir.
+func (g *MySQLGrammar) CompileExplain(query string) (string, error) {
+	switch leadingKeyword(query) {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "REPLACE", "TABLE", "WITH":
+		return "EXPLAIN FORMAT=JSON " + query, nil
+	}
+	return "", ErrExplainNotSupported
+}
+
+// leadingKeyword, baştaki boşluk ve blok yorumlarını atlayarak ifadenin ilk
+// anahtar kelimesini büyük harfle döndürür.
+func leadingKeyword(query string) string {
+	q := strings.TrimSpace(query)
+	for strings.HasPrefix(q, "/*") {
+		end := strings.Index(q, "*/")
+		if end < 0 {
+			return ""
+		}
+		q = strings.TrimSpace(q[end+2:])
+	}
+	end := strings.IndexFunc(q, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+	if end < 0 {
+		end = len(q)
+	}
+	return strings.ToUpper(q[:end])
+}
+
+// CompileSavepoint, "SAVEPOINT `name`" ifadesini derler.
+func (g *MySQLGrammar) CompileSavepoint(name string) (string, error) {
+	wrapped, err := g.wrapSavepoint(name)
+	if err != nil {
+		return "", err
+	}
+	return "SAVEPOINT " + wrapped, nil
+}
+
+// CompileRollbackToSavepoint, "ROLLBACK TO SAVEPOINT `name`" ifadesini derler.
+func (g *MySQLGrammar) CompileRollbackToSavepoint(name string) (string, error) {
+	wrapped, err := g.wrapSavepoint(name)
+	if err != nil {
+		return "", err
+	}
+	return "ROLLBACK TO SAVEPOINT " + wrapped, nil
+}
+
+// CompileReleaseSavepoint, "RELEASE SAVEPOINT `name`" ifadesini derler.
+func (g *MySQLGrammar) CompileReleaseSavepoint(name string) (string, error) {
+	wrapped, err := g.wrapSavepoint(name)
+	if err != nil {
+		return "", err
+	}
+	return "RELEASE SAVEPOINT " + wrapped, nil
+}
+
+// wrapSavepoint, savepoint adını doğrular ve sarar. Savepoint adları
+// nitelikli olamaz; "a.b" biçimindeki adlar reddedilir.
+func (g *MySQLGrammar) wrapSavepoint(name string) (string, error) {
+	if err := validation.ValidateIdentifier(name); err != nil {
+		return "", err
+	}
+	if strings.Contains(name, ".") {
+		return "", &validation.IdentifierError{Identifier: name, Reason: "savepoint name cannot be qualified"}
+	}
+	return g.quote(name), nil
+}
+
+// MySQL ve MariaDB hata numaraları.
+const (
+	mysqlDupEntry           = 1062
+	mysqlDupEntryWithKey    = 1586
+	mysqlRowIsReferenced    = 1217
+	mysqlRowIsReferenced2   = 1451
+	mysqlNoReferencedRow    = 1216
+	mysqlNoReferencedRow2   = 1452
+	mysqlBadNull            = 1048
+	mysqlNoDefaultForField  = 1364
+	mysqlCheckViolated      = 3819
+	mariadbConstraintFailed = 4025
+	mysqlLockWaitTimeout    = 1205
+	mysqlLockNoWait         = 3572
+	mysqlDeadlock           = 1213
+	mysqlServerShutdown     = 1053
+	mariadbConnectionKilled = 1927
+	mysqlInteractionTimeout = 4031
+)
+
+var (
+	mysqlDupKeyMessage     = regexp.MustCompile("for key '([^']+)'")
+	mysqlForeignKeyMessage = regexp.MustCompile("CONSTRAINT `([^`]+)` FOREIGN KEY \\(`([^`]+)`")
+	mysqlNullMessage       = regexp.MustCompile(`(?:Column|Field) '([^']+)'`)
+	mysqlCheckMessage      = regexp.MustCompile("(?:Check constraint '([^']+)'|CONSTRAINT `([^`]+)` failed)")
+)
+
+// ClassifyError, MySQL/MariaDB hata numaralarını kategoriye çevirir ve
+// constraint/sütun adını hata mesajından okur. Numara tanınmazsa standart
+// SQLSTATE değerine bakılır.
+//
+// MySQL 8.0.19+ unique ihlallerinde index adını "tablo.index" biçiminde
+// verir; Constraint yalnızca index adını içerir.
+func (g *MySQLGrammar) ClassifyError(code ErrorCode) ErrorClassification {
+	var c ErrorClassification
+	switch code.Number {
+	case mysqlDupEntry, mysqlDupEntryWithKey:
+		c.Kind = ErrorKindUniqueViolation
+		if m := mysqlDupKeyMessage.FindStringSubmatch(code.Message); m != nil {
+			c.Constraint = m[1][strings.LastIndex(m[1], ".")+1:]
+		}
+	case mysqlRowIsReferenced, mysqlRowIsReferenced2, mysqlNoReferencedRow, mysqlNoReferencedRow2:
+		c.Kind = ErrorKindForeignKeyViolation
+		if m := mysqlForeignKeyMessage.FindStringSubmatch(code.Message); m != nil {
+			c.Constraint, c.Column = m[1], m[2]
+		}
+	case mysqlBadNull, mysqlNoDefaultForField:
+		c.Kind = ErrorKindNotNullViolation
+		if m := mysqlNullMessage.FindStringSubmatch(code.Message); m != nil {
+			c.Column = m[1]
+		}
+	case mysqlCheckViolated, mariadbConstraintFailed:
+		c.Kind = ErrorKindCheckViolation
+		if m := mysqlCheckMessage.FindStringSubmatch(code.Message); m != nil {
+			c.Constraint = m[1] + m[2]
+		}
+	case mysqlDeadlock:
+		c.Kind = ErrorKindDeadlock
+	case mysqlLockWaitTimeout, mysqlLockNoWait:
+		c.Kind = ErrorKindLockTimeout
+	case mysqlServerShutdown, mariadbConnectionKilled, mysqlInteractionTimeout:
+		c.Kind = ErrorKindConnection
+	default:
+		c.Kind = sqlStateKind(code.SQLState)
+	}
+	return c
+}
+
 // CompileUpsert, MySQL'in "ON DUPLICATE KEY UPDATE" özelliğini kullanarak
 // "varsa güncelle, yoksa ekle" (update or insert) mantığını uygular.
 //
 // Modern uygulama geliştirmede idempotent işlemler için kritik bir fonksiyondur.
 func (g *MySQLGrammar) CompileUpsert(b QueryBuilder, data map[string]any, updateColumns []string) (string, []any, error) {
 	// First compile the INSERT part
-	insertSQL, args, err := g.CompileInsert(b, data)
+	insertSQL, args, err := g.compileInsert(b, data)
 	if err != nil {
 		return "", nil, err
 	}
@@ -662,6 +991,12 @@ func (g *MySQLGrammar) CompileUpsert(b QueryBuilder, data map[string]any, update
 	}
 	sql.WriteString(strings.Join(updateParts, ", "))
 
+	returning, err := g.compileReturning(b, "insert")
+	if err != nil {
+		return "", nil, err
+	}
+	sql.WriteString(returning)
+
 	return sql.String(), args, nil
 }
 
@@ -728,6 +1063,11 @@ func (g *MySQLGrammar) compileWhere(where WhereClause) (string, []any, error) {
 		return g.compileWhereDate(where, "MONTH")
 	case WhereTypeDay:
 		return g.compileWhereDate(where, "DAY")
+	case WhereTypeFullText:
+		if where.FullText == nil {
+			return "", nil, ErrNoColumns
+		}
+		return g.compileFullText(*where.FullText)
 	default:
 		return "", nil, fmt.Errorf("unknown where type: %v", where.Type)
 	}
@@ -821,26 +1161,291 @@ func (g *MySQLGrammar) compileWhereNested(where WhereClause) (string, []any, err
 }
 
 // compileWhereDate, tarih bazlı özel sorguları derler.
+// fn, MySQL fonksiyon adıdır (DATE, YEAR, MONTH, DAY); PostgreSQL ve SQLite
+// bunu kendi tarih ifadelerine çevirir.
 func (g *MySQLGrammar) compileWhereDate(where WhereClause, fn string) (string, []any, error) {
 	column, err := g.Wrap(where.Column)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return fn + "(" + column + ") = ?", []any{where.Value}, nil
+	switch g.engine {
+	case enginePostgres:
+		column = postgresDatePart(fn, column)
+	case engineSQLite:
+		column = sqliteDatePart(fn, column)
+	default:
+		column = fn + "(" + column + ")"
+	}
+
+	return column + " = ?", []any{where.Value}, nil
+}
+
+// compileFullText, tam metin arama koşulunu derler. MySQL'de FULLTEXT
+// indeksleri üzerinde çalışan "MATCH(col1, col2) AGAINST(? IN BOOLEAN MODE)",
+// PostgreSQL'de "to_tsvector(...) @@ plainto_tsquery(?)", SQLite'ta FTS5
+// "col MATCH ?" ifadesi üretilir.
+//
+// Arama metni asla SQL'e gömülmez, her zaman parametre olarak bağlanır;
+// sütun adları ise Wrap ile doğrulanır. MySQL'de aynı ifade ORDER BY'da
+// alaka skoru olarak da kullanılır (bkz. compileRelevance).
+func (g *MySQLGrammar) compileFullText(ft FullTextClause) (string, []any, error) {
+	wrappedCols, err := g.wrapFullTextColumns(ft)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch g.engine {
+	case enginePostgres:
+		return postgresFullText(wrappedCols, ft)
+	case engineSQLite:
+		return sqliteFullText(wrappedCols, ft)
+	}
+
+	var modifier string
+	switch ft.Options.Mode {
+	case "", FullTextBoolean:
+		modifier = " IN BOOLEAN MODE"
+	case FullTextNatural:
+		modifier = " IN NATURAL LANGUAGE MODE"
+	case FullTextExpansion:
+		modifier = " WITH QUERY EXPANSION"
+	default:
+		return "", nil, ErrInvalidFullText
+	}
+
+	return "MATCH(" + strings.Join(wrappedCols, ", ") + ") AGAINST(?" + modifier + ")", []any{ft.Query}, nil
+}
+
+// compileRelevance, OrderByRelevance için alaka skoru ifadesini derler.
+// Büyük skor her veritabanında daha alakalı sonuç anlamına gelir; böylece
+// azalan sıralama en alakalı satırları başa getirir.
+func (g *MySQLGrammar) compileRelevance(ft FullTextClause) (string, []any, error) {
+	switch g.engine {
+	case enginePostgres:
+		wrappedCols, err := g.wrapFullTextColumns(ft)
+		if err != nil {
+			return "", nil, err
+		}
+		return postgresRelevance(wrappedCols, ft)
+	case engineSQLite:
+		if _, err := g.wrapFullTextColumns(ft); err != nil {
+			return "", nil, err
+		}
+		return sqliteRelevance(ft)
+	default:
+		return g.compileFullText(ft)
+	}
+}
+
+// wrapFullTextColumns, arama sütunlarını doğrular ve sarar.
+func (g *MySQLGrammar) wrapFullTextColumns(ft FullTextClause) ([]string, error) {
+	if len(ft.Columns) == 0 {
+		return nil, ErrNoColumns
+	}
+
+	wrappedCols := make([]string, len(ft.Columns))
+	for i, col := range ft.Columns {
+		wrapped, err := g.Wrap(col)
+		if err != nil {
+			return nil, err
+		}
+		wrappedCols[i] = wrapped
+	}
+	return wrappedCols, nil
+}
+
+// compileLock, MySQL 8 ve PostgreSQL satır kilitleme ifadesini oluşturur:
+// " FOR UPDATE [OF `t1`, `t2`] [SKIP LOCKED | NOWAIT]".
+// MariaDB için ayrı kurallar uygulanır (bkz. compileMariaDBLock). SQLite'ta
+// satır kilidi yoktur (yazma kilidi veritabanı düzeyindedir); geçerli bir
+// kilit yok sayılır.
+func (g *MySQLGrammar) compileLock(lock LockClause) (string, error) {
+	switch g.engine {
+	case engineMariaDB:
+		return g.compileMariaDBLock(lock)
+	case engineSQLite:
+		if _, err := g.compileLockOptions(lock); err != nil {
+			return "", err
+		}
+		return "", nil
+	}
+	return g.compileLockOptions(lock)
+}
+
+// compileLockOptions, kilidi doğrular ve FOR UPDATE/FOR SHARE ifadesini
+// seçenekleriyle birlikte derler.
+func (g *MySQLGrammar) compileLockOptions(lock LockClause) (string, error) {
+	var sql strings.Builder
+
+	switch lock.Strength {
+	case LockUpdate:
+		sql.WriteString(" FOR UPDATE")
+	case LockShare:
+		sql.WriteString(" FOR SHARE")
+	default:
+		return "", ErrInvalidLock
+	}
+
+	if len(lock.Of) > 0 {
+		tables := make([]string, len(lock.Of))
+		for i, table := range lock.Of {
+			wrapped, err := g.Wrap(table)
+			if err != nil {
+				return "", err
+			}
+			tables[i] = wrapped
+		}
+		sql.WriteString(" OF ")
+		sql.WriteString(strings.Join(tables, ", "))
+	}
+
+	switch lock.Wait {
+	case LockWaitDefault:
+	case LockSkipLocked, LockNoWait:
+		sql.WriteString(" ")
+		sql.WriteString(string(lock.Wait))
+	default:
+		return "", ErrInvalidLock
+	}
+
+	return sql.String(), nil
+}
+
+// compileMariaDBLock, MariaDB satır kilitleme ifadesini oluşturur:
+// " FOR UPDATE [NOWAIT]" veya " LOCK IN SHARE MODE [NOWAIT]" (NOWAIT
+// 10.3+). MariaDB'de OF cümlesi yoktur ve SKIP LOCKED yalnızca 10.6+
+// sürümlerde bulunur; bu seçenekler geçersiz SQL üretmek yerine
+// ErrUnsupportedFeature döndürür.
+func (g *MySQLGrammar) compileMariaDBLock(lock LockClause) (string, error) {
+	var sql string
+	switch lock.Strength {
+	case LockUpdate:
+		sql = " FOR UPDATE"
+	case LockShare:
+		sql = " LOCK IN SHARE MODE"
+	default:
+		return "", ErrInvalidLock
+	}
+
+	switch lock.Wait {
+	case LockWaitDefault:
+	case LockNoWait:
+		sql += " NOWAIT"
+	case LockSkipLocked:
+		return "", ErrUnsupportedFeature
+	default:
+		return "", ErrInvalidLock
+	}
+
+	if len(lock.Of) > 0 {
+		return "", ErrUnsupportedFeature
+	}
+	return sql, nil
+}
+
+// compileValue, INSERT/UPDATE için tek bir değeri derler.
+// Düz değerler placeholder olarak bağlanır; Expression (Raw, Expr vb.)
+// değerleri ise bağlamalarıyla birlikte SQL'e gömülür.
+func (g *MySQLGrammar) compileValue(value any, index int) (string, []any, error) {
+	if expr, ok := value.(Expression); ok {
+		return expr.CompileExpression(g.Wrap)
+	}
+	return g.Placeholder(index), []any{value}, nil
+}
+
+// compileReturning, builder'da RETURNING kolonları varsa " RETURNING ..."
+// parçasını üretir. MySQL RETURNING desteklemez; MariaDB yalnızca INSERT ve
+// DELETE için, PostgreSQL ve SQLite 3.35+ ise tüm yazma ifadeleri için
+// destekler. Desteklenmeyen durumda ifade sessizce RETURNING'siz
+// çalıştırılmak yerine ErrReturningNotSupported döner.
+func (g *MySQLGrammar) compileReturning(b QueryBuilder, statement string) (string, error) {
+	columns := b.GetReturning()
+	if len(columns) == 0 {
+		return "", nil
+	}
+	if !g.returning || (g.engine == engineMariaDB && statement == "update") {
+		return "", ErrReturningNotSupported
+	}
+
+	wrapped := make([]string, len(columns))
+	for i, col := range columns {
+		w, err := g.Wrap(col)
+		if err != nil {
+			return "", err
+		}
+		wrapped[i] = w
+	}
+
+	return " RETURNING " + strings.Join(wrapped, ", "), nil
+}
+
+// compileJoins, tüm JOIN ifadelerini başında boşlukla birlikte derler.
+// Alt sorgu JOIN'lerinin bağlamaları sırasıyla döndürülür.
+func (g *MySQLGrammar) compileJoins(joins []JoinClause) (string, []any, error) {
+	var sql strings.Builder
+	args := make([]any, 0)
+
+	for _, join := range joins {
+		joinSQL, joinArgs, err := g.compileJoin(join)
+		if err != nil {
+			return "", nil, err
+		}
+		sql.WriteString(" ")
+		sql.WriteString(joinSQL)
+		args = append(args, joinArgs...)
+	}
+
+	return sql.String(), args, nil
 }
 
 // compileJoin, tablolar arası ilişki kuran JOIN ifadelerini derler.
-func (g *MySQLGrammar) compileJoin(join JoinClause) (string, error) {
-	table, err := g.WrapTable(join.Table)
+// Subquery dolu ise tablo yerine "(SELECT ...) AS `alias`" kullanılır.
+func (g *MySQLGrammar) compileJoin(join JoinClause) (string, []any, error) {
+	table, args, err := g.compileJoinTable(join)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	if join.Type == JoinCross {
-		return "CROSS JOIN " + table, nil
+		return "CROSS JOIN " + table, args, nil
+	}
+
+	on, err := g.compileJoinCondition(join)
+	if err != nil {
+		return "", nil, err
 	}
 
+	return string(join.Type) + " JOIN " + table + " ON " + on, args, nil
+}
+
+// compileJoinTable, JOIN edilen tabloyu veya "(SELECT ...) AS alias" alt
+// sorgusunu bağlamalarıyla birlikte derler.
+func (g *MySQLGrammar) compileJoinTable(join JoinClause) (string, []any, error) {
+	if join.Subquery == nil {
+		table, err := g.WrapTable(join.Table)
+		if err != nil {
+			return "", nil, err
+		}
+		return table, nil, nil
+	}
+
+	if join.Alias == "" {
+		return "", nil, ErrSubqueryAlias
+	}
+	subSQL, subArgs, err := g.CompileSelect(join.Subquery)
+	if err != nil {
+		return "", nil, err
+	}
+	alias, err := g.Wrap(join.Alias)
+	if err != nil {
+		return "", nil, err
+	}
+	return "(" + subSQL + ") AS " + alias, subArgs, nil
+}
+
+// compileJoinCondition, JOIN'in "first op second" eşleşme koşulunu derler.
+func (g *MySQLGrammar) compileJoinCondition(join JoinClause) (string, error) {
 	first, err := g.Wrap(join.First)
 	if err != nil {
 		return "", err
@@ -855,5 +1460,5 @@ func (g *MySQLGrammar) compileJoin(join JoinClause) (string, error) {
 		return "", err
 	}
 
-	return string(join.Type) + " JOIN " + table + " ON " + first + " " + join.Operator + " " + second, nil
+	return first + " " + join.Operator + " " + second, nil
 }
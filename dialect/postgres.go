@@ -0,0 +1,390 @@
+package dialect
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/biyonik/go-fluent-sql/internal/validation"
+)
+
+/*
+ * ----------------------------------------------------------------------------
+ * POSTGRESQL GRAMMAR IMPLEMENTATION
+ * ----------------------------------------------------------------------------
+ *
+ * PostgreSQL grameri, MySQLGrammar'ın derleme hattını (SELECT -> FROM ->
+ * WHERE -> ORDER ...) paylaşır; veritabanına özgü farklar şunlardır:
+ *
+ * 1. Tırnaklama: Tanımlayıcılar standart çift tırnakla ("users"."id") sarılır.
+ * 2. Parametreler: Sorgu "?" yer tutucularıyla derlenir ve en sonda tek
+ * geçişte "$1, $2 ..." biçimine çevrilir (bkz. rebind). Böylece WhereRaw ve
+ * Raw ifadelerinde de "?" kullanılmaya devam edilir.
+ * 3. Tam metin arama: to_tsvector(...) @@ plainto_tsquery(?).
+ * 4. Tarih koşulları: CAST(col AS DATE) ve EXTRACT(YEAR FROM col).
+ * 5. Bağlı yazma: UPDATE ... FROM ve DELETE ... USING.
+ * 6. INSERT, UPDATE ve DELETE ifadelerinde RETURNING.
+ *
+ * @author Ahmet ALTUN
+ * @github github.com/biyonik
+ * @linkedin linkedin.com/in/biyonik
+ * @email ahmet.altun60@gmail.com
+ * ----------------------------------------------------------------------------
+ */
+
+// PostgresGrammar, Grammar arayüzünü PostgreSQL için implemente eder.
+//
+// Ortak derleme mantığı gömülü MySQLGrammar'dan gelir; dışa açık Compile*
+// metotları sonucu PostgreSQL'in numaralı yer tutucularına çevirir.
+//
+// Not: "?" karakteri tırnak dışında her zaman parametre olarak yorumlanır.
+// jsonb'nin "?" operatörü yerine jsonb_exists() kullanın.
+type PostgresGrammar struct {
+	MySQLGrammar
+}
+
+// Postgres, yeni bir PostgreSQL dilbilgisi örneği oluşturur.
+func Postgres() *PostgresGrammar {
+	return &PostgresGrammar{
+		MySQLGrammar: MySQLGrammar{
+			BaseGrammar: BaseGrammar{
+				name:       "postgres",
+				dateFormat: "2006-01-02 15:04:05",
+			},
+			returning: true,
+			engine:    enginePostgres,
+		},
+	}
+}
+
+// Placeholder, PostgreSQL'in numaralı yer tutucusunu döndürür ("$1", "$2" ...).
+// index sıfırdan başlar.
+func (g *PostgresGrammar) Placeholder(index int) string {
+	return "$" + strconv.Itoa(index+1)
+}
+
+// CompileSelect, SELECT sorgusunu derler.
+func (g *PostgresGrammar) CompileSelect(b QueryBuilder) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileSelect(b))
+}
+
+// CompileInsert, INSERT sorgusunu derler.
+func (g *PostgresGrammar) CompileInsert(b QueryBuilder, data map[string]any) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileInsert(b, data))
+}
+
+// CompileInsertBatch, toplu INSERT sorgusunu derler.
+func (g *PostgresGrammar) CompileInsertBatch(b QueryBuilder, data []map[string]any) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileInsertBatch(b, data))
+}
+
+// CompileUpdate, UPDATE sorgusunu derler. JOIN içeren builder'lar
+// "UPDATE a SET ... FROM b WHERE ..." biçiminde yazılır.
+func (g *PostgresGrammar) CompileUpdate(b QueryBuilder, data map[string]any) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileUpdate(b, data))
+}
+
+// CompileDelete, DELETE sorgusunu derler. JOIN içeren builder'lar
+// "DELETE FROM a USING b WHERE ..." biçiminde yazılır.
+func (g *PostgresGrammar) CompileDelete(b QueryBuilder) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileDelete(b))
+}
+
+// CompileExists, EXISTS sorgusunu derler.
+func (g *PostgresGrammar) CompileExists(b QueryBuilder) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileExists(b))
+}
+
+// CompileCount, COUNT sorgusunu derler.
+func (g *PostgresGrammar) CompileCount(b QueryBuilder, column string) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileCount(b, column))
+}
+
+// CompileAggregate, SUM, AVG, MIN, MAX sorgularını derler.
+func (g *PostgresGrammar) CompileAggregate(b QueryBuilder, fn, column string) (string, []any, error) {
+	return rebind(g.MySQLGrammar.CompileAggregate(b, fn, column))
+}
+
+// CompileUpsert, PostgreSQL'de desteklenmez: ON CONFLICT DO UPDATE bir
+// çakışma hedefi (unique sütunlar) ister ve Grammar arayüzü bunu taşımaz.
+func (g *PostgresGrammar) CompileUpsert(b QueryBuilder, data map[string]any, updateColumns []string) (string, []any, error) {
+	return "", nil, ErrUnsupportedFeature
+}
+
+// CompileExplain, sorguyu "EXPLAIN (FORMAT JSON)" ile sarar. ANALYZE
+// kullanılmadığı için sorgu çalıştırılmaz; plan tek elemanlı bir JSON dizisi
+// olarak döner.
+func (g *PostgresGrammar) CompileExplain(query string) (string, error) {
+	switch leadingKeyword(query) {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "TABLE", "WITH", "VALUES":
+		return "EXPLAIN (FORMAT JSON) " + query, nil
+	}
+	return "", ErrExplainNotSupported
+}
+
+var (
+	postgresConstraintMessage = regexp.MustCompile(`constraint "([^"]+)"`)
+	postgresColumnMessage     = regexp.MustCompile(`column "([^"]+)"`)
+)
+
+// ClassifyError, hatayı SQLSTATE değerine göre sınıflandırır ve
+// constraint/sütun adını PostgreSQL hata mesajından okur.
+func (g *PostgresGrammar) ClassifyError(code ErrorCode) ErrorClassification {
+	c := ErrorClassification{Kind: sqlStateKind(code.SQLState)}
+	switch c.Kind {
+	case ErrorKindUniqueViolation, ErrorKindForeignKeyViolation, ErrorKindCheckViolation:
+		if m := postgresConstraintMessage.FindStringSubmatch(code.Message); m != nil {
+			c.Constraint = m[1]
+		}
+	case ErrorKindNotNullViolation:
+		if m := postgresColumnMessage.FindStringSubmatch(code.Message); m != nil {
+			c.Column = m[1]
+		}
+	}
+	return c
+}
+
+// compileUpdateFrom, JOIN içeren UPDATE'i PostgreSQL ve SQLite 3.33+
+// sözdizimiyle derler:
+//
+//	UPDATE "a" SET "col" = ? FROM "b" WHERE "b"."a_id" = "a"."id" AND (...)
+//
+// JOIN tabloları FROM listesine, ON koşulları WHERE'e taşınır. Bu biçim
+// yalnızca INNER ve CROSS JOIN'in anlamını korur; LEFT/RIGHT JOIN
+// ErrJoinNotSupported döner. SET yalnızca hedef tablonun sütunlarını
+// güncelleyebilir: "users.flagged" gibi hedefle nitelenmiş anahtarlar
+// çıplak sütuna çevrilir, başka bir tabloya ait anahtarlar
+// ErrUnsupportedFeature döner.
+func (g *MySQLGrammar) compileUpdateFrom(b QueryBuilder, data map[string]any) (string, []any, error) {
+	name, alias, err := validation.ValidateTableWithAlias(b.GetTable())
+	if err != nil {
+		return "", nil, err
+	}
+	table, err := g.WrapTable(b.GetTable())
+	if err != nil {
+		return "", nil, err
+	}
+
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := make([]any, 0)
+	setParts := make([]string, len(keys))
+	for i, key := range keys {
+		column := key
+		if dot := strings.Index(key, "."); dot >= 0 {
+			qualifier := key[:dot]
+			if qualifier != name && qualifier != alias {
+				return "", nil, ErrUnsupportedFeature
+			}
+			column = key[dot+1:]
+		}
+		wrapped, err := g.Wrap(column)
+		if err != nil {
+			return "", nil, err
+		}
+		valueSQL, valueArgs, err := g.compileValue(data[key], len(args))
+		if err != nil {
+			return "", nil, err
+		}
+		setParts[i] = wrapped + " = " + valueSQL
+		args = append(args, valueArgs...)
+	}
+
+	from, conditions, fromArgs, err := g.compileFromJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, fromArgs...)
+
+	whereSQL, whereArgs, err := g.compileJoinedWheres(conditions, b.GetWheres())
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, whereArgs...)
+
+	returning, err := g.compileReturning(b, "update")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "UPDATE " + table + " SET " + strings.Join(setParts, ", ") + " FROM " + from + whereSQL + returning, args, nil
+}
+
+// compileDeleteUsing, JOIN içeren DELETE'i PostgreSQL sözdizimiyle derler:
+//
+//	DELETE FROM "a" USING "b" WHERE "b"."a_id" = "a"."id" AND (...)
+//
+// Yalnızca ana tablonun satırları silinir. JOIN türleri için
+// compileUpdateFrom'daki kurallar geçerlidir.
+func (g *MySQLGrammar) compileDeleteUsing(b QueryBuilder) (string, []any, error) {
+	table, err := g.WrapTable(b.GetTable())
+	if err != nil {
+		return "", nil, err
+	}
+
+	using, conditions, args, err := g.compileFromJoins(b.GetJoins())
+	if err != nil {
+		return "", nil, err
+	}
+
+	whereSQL, whereArgs, err := g.compileJoinedWheres(conditions, b.GetWheres())
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, whereArgs...)
+
+	returning, err := g.compileReturning(b, "delete")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "DELETE FROM " + table + " USING " + using + whereSQL + returning, args, nil
+}
+
+// compileFromJoins, JOIN'leri virgülle ayrılmış bir tablo listesine ve
+// WHERE'e eklenecek eşleşme koşullarına ayırır.
+func (g *MySQLGrammar) compileFromJoins(joins []JoinClause) (string, []string, []any, error) {
+	tables := make([]string, len(joins))
+	conditions := make([]string, 0, len(joins))
+	args := make([]any, 0)
+
+	for i, join := range joins {
+		if join.Type != JoinInner && join.Type != JoinCross {
+			return "", nil, nil, ErrJoinNotSupported
+		}
+		table, tableArgs, err := g.compileJoinTable(join)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		tables[i] = table
+		args = append(args, tableArgs...)
+
+		if join.Type == JoinCross {
+			continue
+		}
+		on, err := g.compileJoinCondition(join)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		conditions = append(conditions, on)
+	}
+
+	return strings.Join(tables, ", "), conditions, args, nil
+}
+
+// compileJoinedWheres, JOIN eşleşme koşullarını builder'ın WHERE koşullarıyla
+// birleştirir. Kullanıcı koşulları OR içerebileceği için parantez içine alınır.
+func (g *MySQLGrammar) compileJoinedWheres(conditions []string, wheres []WhereClause) (string, []any, error) {
+	parts := append([]string(nil), conditions...)
+
+	var args []any
+	if len(wheres) > 0 {
+		whereSQL, whereArgs, err := g.compileWheres(wheres)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(parts) > 0 {
+			whereSQL = "(" + whereSQL + ")"
+		}
+		parts = append(parts, whereSQL)
+		args = whereArgs
+	}
+
+	if len(parts) == 0 {
+		return "", nil, nil
+	}
+	return " WHERE " + strings.Join(parts, " AND "), args, nil
+}
+
+// rebind, "?" yer tutucularını sırasıyla "$1, $2 ..." biçimine çevirir.
+// Tek tırnaklı string literalleri ve çift tırnaklı tanımlayıcılar içindeki
+// "?" karakterlerine dokunulmaz.
+func rebind(query string, args []any, err error) (string, []any, error) {
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sql strings.Builder
+	sql.Grow(len(query) + len(args))
+
+	n := 0
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			n++
+			sql.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sql.WriteByte(c)
+	}
+
+	return sql.String(), args, nil
+}
+
+// postgresDatePart, MySQL tarih fonksiyonunu (DATE, YEAR, MONTH, DAY)
+// PostgreSQL karşılığına çevirir.
+func postgresDatePart(fn, column string) string {
+	if fn == "DATE" {
+		return "CAST(" + column + " AS DATE)"
+	}
+	return "EXTRACT(" + fn + " FROM " + column + ")"
+}
+
+// postgresDocument, arama sütunlarını tek bir tsvector belgesine birleştirir.
+// NULL sütunlar belgenin tamamını NULL yapmasın diye boş stringe çevrilir.
+func postgresDocument(wrappedCols []string) string {
+	if len(wrappedCols) == 1 {
+		return "to_tsvector(" + wrappedCols[0] + ")"
+	}
+	parts := make([]string, len(wrappedCols))
+	for i, col := range wrappedCols {
+		parts[i] = "coalesce(" + col + ", '')"
+	}
+	return "to_tsvector(" + strings.Join(parts, " || ' ' || ") + ")"
+}
+
+// postgresTSQuery, arama metnini bağlayan tsquery ifadesini döndürür.
+// Boolean ve doğal dil modları plainto_tsquery ile çalışır; PostgreSQL'de
+// sorgu genişletmenin karşılığı yoktur.
+func postgresTSQuery(mode FullTextMode) (string, error) {
+	switch mode {
+	case "", FullTextBoolean, FullTextNatural:
+		return "plainto_tsquery(?)", nil
+	case FullTextExpansion:
+		return "", ErrUnsupportedFeature
+	default:
+		return "", ErrInvalidFullText
+	}
+}
+
+// postgresFullText, "to_tsvector(...) @@ plainto_tsquery(?)" koşulunu üretir.
+func postgresFullText(wrappedCols []string, ft FullTextClause) (string, []any, error) {
+	query, err := postgresTSQuery(ft.Options.Mode)
+	if err != nil {
+		return "", nil, err
+	}
+	return postgresDocument(wrappedCols) + " @@ " + query, []any{ft.Query}, nil
+}
+
+// postgresRelevance, "ts_rank(to_tsvector(...), plainto_tsquery(?))" alaka
+// skorunu üretir.
+func postgresRelevance(wrappedCols []string, ft FullTextClause) (string, []any, error) {
+	query, err := postgresTSQuery(ft.Options.Mode)
+	if err != nil {
+		return "", nil, err
+	}
+	return "ts_rank(" + postgresDocument(wrappedCols) + ", " + query + ")", []any{ft.Query}, nil
+}
@@ -0,0 +1,210 @@
+package dialect
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+/*
+ * ----------------------------------------------------------------------------
+ * SQLITE GRAMMAR IMPLEMENTATION
+ * ----------------------------------------------------------------------------
+ *
+ * SQLite grameri, MySQLGrammar'ın derleme hattını paylaşır. "?" yer
+ * tutucuları SQLite'ta olduğu gibi kullanılır; farklar şunlardır:
+ *
+ * 1. Tırnaklama: Tanımlayıcılar standart çift tırnakla sarılır.
+ * 2. Tam metin arama: FTS5 sanal tablolarında "col MATCH ?".
+ * 3. Tarih koşulları: date(col) ve strftime('%Y', col).
+ * 4. TRUNCATE yoktur; tablo "DELETE FROM" ile boşaltılır.
+ * 5. JOIN içeren UPDATE, 3.33+ "UPDATE ... FROM" ile yazılır; DELETE'te
+ * JOIN karşılığı yoktur.
+ * 6. INSERT, UPDATE ve DELETE ifadelerinde RETURNING (3.35+).
+ *
+ * @author Ahmet ALTUN
+ * @github github.com/biyonik
+ * @linkedin linkedin.com/in/biyonik
+ * @email ahmet.altun60@gmail.com
+ * ----------------------------------------------------------------------------
+ */
+
+// SQLiteGrammar, Grammar arayüzünü SQLite 3 için implemente eder.
+//
+// Ortak derleme mantığı gömülü MySQLGrammar'dan gelir.
+type SQLiteGrammar struct {
+	MySQLGrammar
+}
+
+// SQLite, yeni bir SQLite dilbilgisi örneği oluşturur.
+func SQLite() *SQLiteGrammar {
+	return &SQLiteGrammar{
+		MySQLGrammar: MySQLGrammar{
+			BaseGrammar: BaseGrammar{
+				name:       "sqlite",
+				dateFormat: "2006-01-02 15:04:05",
+			},
+			returning: true,
+			engine:    engineSQLite,
+		},
+	}
+}
+
+// CompileDelete, DELETE sorgusunu derler. SQLite DELETE ifadesinde JOIN
+// desteklemez; JOIN içeren builder'lar ErrJoinNotSupported döner.
+func (g *SQLiteGrammar) CompileDelete(b QueryBuilder) (string, []any, error) {
+	if len(b.GetJoins()) > 0 {
+		return "", nil, ErrJoinNotSupported
+	}
+	return g.MySQLGrammar.CompileDelete(b)
+}
+
+// CompileTruncate, SQLite'ta TRUNCATE olmadığı için tabloyu "DELETE FROM"
+// ile boşaltır. SQLite bu biçimi dahili olarak hızlı yoldan çalıştırır.
+func (g *SQLiteGrammar) CompileTruncate(b QueryBuilder) (string, error) {
+	if b.GetTable() == "" {
+		return "", ErrNoTable
+	}
+	if len(b.GetJoins()) > 0 {
+		return "", ErrJoinNotSupported
+	}
+
+	table, err := g.WrapTable(b.GetTable())
+	if err != nil {
+		return "", err
+	}
+
+	return "DELETE FROM " + table, nil
+}
+
+// CompileUpsert, "INSERT ... ON CONFLICT DO UPDATE SET col = excluded.col"
+// ifadesini derler. Çakışma hedefi verilmediği için SQLite 3.35+ gerekir.
+func (g *SQLiteGrammar) CompileUpsert(b QueryBuilder, data map[string]any, updateColumns []string) (string, []any, error) {
+	insertSQL, args, err := g.compileInsert(b, data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(updateColumns) == 0 {
+		updateColumns = make([]string, 0, len(data))
+		for k := range data {
+			updateColumns = append(updateColumns, k)
+		}
+		sort.Strings(updateColumns)
+	}
+
+	updateParts := make([]string, len(updateColumns))
+	for i, col := range updateColumns {
+		wrapped, err := g.Wrap(col)
+		if err != nil {
+			return "", nil, err
+		}
+		updateParts[i] = wrapped + " = excluded." + wrapped
+	}
+
+	returning, err := g.compileReturning(b, "insert")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return insertSQL + " ON CONFLICT DO UPDATE SET " + strings.Join(updateParts, ", ") + returning, args, nil
+}
+
+// CompileExplain, SQLite için desteklenmez: EXPLAIN QUERY PLAN makine
+// tarafından okunabilir bir plan döndürmez.
+func (g *SQLiteGrammar) CompileExplain(query string) (string, error) {
+	return "", ErrExplainNotSupported
+}
+
+var (
+	sqliteConstraintMessage = regexp.MustCompile(`^(UNIQUE|NOT NULL|CHECK|FOREIGN KEY) constraint failed(?:: (\S+))?`)
+	sqliteLockedMessage     = regexp.MustCompile(`^database (?:table )?is locked`)
+)
+
+// ClassifyError, SQLite hata mesajlarını kategoriye çevirir. SQLite
+// sürücüleri SQLSTATE üretmez; constraint türü ve ilgili sütun mesajda yer
+// alır ("UNIQUE constraint failed: users.email"). SQLITE_BUSY ("database is
+// locked") kilit beklemesi zaman aşımı olarak sınıflandırılır.
+func (g *SQLiteGrammar) ClassifyError(code ErrorCode) ErrorClassification {
+	var c ErrorClassification
+	if m := sqliteConstraintMessage.FindStringSubmatch(code.Message); m != nil {
+		switch m[1] {
+		case "UNIQUE":
+			c.Kind = ErrorKindUniqueViolation
+		case "NOT NULL":
+			c.Kind = ErrorKindNotNullViolation
+		case "CHECK":
+			c.Kind = ErrorKindCheckViolation
+			c.Constraint = m[2]
+			return c
+		case "FOREIGN KEY":
+			c.Kind = ErrorKindForeignKeyViolation
+			return c
+		}
+		// "tablo.sütun[, tablo.sütun]" → ilk sütun
+		column := strings.TrimSuffix(m[2], ",")
+		c.Column = column[strings.LastIndex(column, ".")+1:]
+		return c
+	}
+	if sqliteLockedMessage.MatchString(code.Message) {
+		c.Kind = ErrorKindLockTimeout
+		return c
+	}
+	c.Kind = sqlStateKind(code.SQLState)
+	return c
+}
+
+// sqliteDatePart, MySQL tarih fonksiyonunu (DATE, YEAR, MONTH, DAY) SQLite
+// karşılığına çevirir. Yıl, ay ve gün tamsayı olarak karşılaştırılır.
+func sqliteDatePart(fn, column string) string {
+	switch fn {
+	case "YEAR":
+		return "CAST(strftime('%Y', " + column + ") AS INTEGER)"
+	case "MONTH":
+		return "CAST(strftime('%m', " + column + ") AS INTEGER)"
+	case "DAY":
+		return "CAST(strftime('%d', " + column + ") AS INTEGER)"
+	default:
+		return "date(" + column + ")"
+	}
+}
+
+// sqliteFullText, FTS5 "col MATCH ?" koşulunu üretir. Sol taraf FTS5
+// tablosunun adı (tüm sütunlarda arama) veya tek bir sütunudur; FTS5 birden
+// fazla MATCH'i OR ile birleştiremediği için çok sütunlu arama
+// ErrUnsupportedFeature döner. Arama metni FTS5 sorgu sözdizimiyle yorumlanır.
+func sqliteFullText(wrappedCols []string, ft FullTextClause) (string, []any, error) {
+	if err := sqliteFullTextMode(ft.Options.Mode); err != nil {
+		return "", nil, err
+	}
+	if len(wrappedCols) != 1 {
+		return "", nil, ErrUnsupportedFeature
+	}
+	return wrappedCols[0] + " MATCH ?", []any{ft.Query}, nil
+}
+
+// sqliteRelevance, FTS5'in gizli "rank" sütununu (bm25) alaka skoru olarak
+// kullanır. rank küçüldükçe alaka arttığı için işareti çevrilir; azalan
+// sıralama en alakalı satırları başa getirir. Aynı sorguda MATCH koşulu
+// bulunmalıdır.
+func sqliteRelevance(ft FullTextClause) (string, []any, error) {
+	if err := sqliteFullTextMode(ft.Options.Mode); err != nil {
+		return "", nil, err
+	}
+	if len(ft.Columns) != 1 {
+		return "", nil, ErrUnsupportedFeature
+	}
+	return "-rank", nil, nil
+}
+
+// sqliteFullTextMode, FTS5'te karşılığı olan arama modlarını kabul eder.
+func sqliteFullTextMode(mode FullTextMode) error {
+	switch mode {
+	case "", FullTextBoolean, FullTextNatural:
+		return nil
+	case FullTextExpansion:
+		return ErrUnsupportedFeature
+	default:
+		return ErrInvalidFullText
+	}
+}
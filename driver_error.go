@@ -0,0 +1,178 @@
+package fluentsql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+// -----------------------------------------------------------------------------
+//  Sürücü Hata Kodları
+//
+//  Çekirdek paket hiçbir veritabanı sürücüsünü import etmez. Bu nedenle
+//  sürücü hatalarındaki kodlar, yaygın sürücülerin hata tiplerindeki alan
+//  adlarından reflection ile okunur:
+//
+//   • go-sql-driver/mysql  *MySQLError{Number uint16, SQLState [5]byte}
+//   • lib/pq               *pq.Error{Code ErrorCode, Constraint, Column string}
+//   • jackc/pgx            *pgconn.PgError{Code, ConstraintName, ColumnName string}
+//
+//  Alan bulunamazsa mesajdaki "Error 1213 (40001):" veya "(SQLSTATE 40P01)"
+//  kalıplarına bakılır. PostgreSQL sürücülerinin yapısal constraint ve sütun
+//  alanları, mesajdan çıkarılan adlardan önceliklidir.
+//
+//  Okunan kodlar grammar'ın ClassifyError metoduna verilir; tanınan hatalar
+//  sürücüye dönmeden önce DatabaseError ile sarılır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+var (
+	mysqlMessageCode = regexp.MustCompile(`Error (\d{4,5})(?: \(([0-9A-Z]{5})\))?:`)
+	sqlStateMessage  = regexp.MustCompile(`\(SQLSTATE ([0-9A-Z]{5})\)`)
+)
+
+// errorKinds, dialect kategorilerini sentinel hatalara eşler.
+var errorKinds = map[dialect.ErrorKind]error{
+	dialect.ErrorKindUniqueViolation:     ErrUniqueViolation,
+	dialect.ErrorKindForeignKeyViolation: ErrForeignKeyViolation,
+	dialect.ErrorKindNotNullViolation:    ErrNotNullViolation,
+	dialect.ErrorKindCheckViolation:      ErrCheckViolation,
+	dialect.ErrorKindDeadlock:            ErrDeadlock,
+	dialect.ErrorKindLockTimeout:         ErrLockTimeout,
+	dialect.ErrorKindSerialization:       ErrSerialization,
+	dialect.ErrorKindConnection:          ErrConnectionLost,
+}
+
+// classifyError, sürücü hatasını grammar ile sınıflandırır ve tanınırsa
+// DatabaseError ile sarar. Context hataları ve zaten sınıflandırılmış
+// hatalar olduğu gibi döner.
+func classifyError(grammar dialect.Grammar, err error) error {
+	var dbErr *DatabaseError
+	if err == nil || grammar == nil || errors.As(err, &dbErr) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	code := driverCode(err)
+	if code.number == 0 && code.state == "" {
+		return err
+	}
+	c := grammar.ClassifyError(dialect.ErrorCode{Number: code.number, SQLState: code.state, Message: err.Error()})
+	kind, ok := errorKinds[c.Kind]
+	if !ok {
+		return err
+	}
+	if code.constraint != "" {
+		c.Constraint = code.constraint
+	}
+	if code.column != "" {
+		c.Column = code.column
+	}
+	return &DatabaseError{Kind: kind, Constraint: c.Constraint, Column: c.Column, Err: err}
+}
+
+// driverError, bir sürücü hatasından okunan kod ve adlardır.
+type driverError struct {
+	number     int    // MySQL hata numarası
+	state      string // SQLSTATE
+	constraint string // PostgreSQL sürücülerinin constraint alanı
+	column     string // PostgreSQL sürücülerinin sütun alanı
+}
+
+// driverCode, hata zincirindeki ilk sürücü hatasının sayısal kodunu
+// (MySQL hata numarası), SQLSTATE değerini ve varsa constraint ve sütun
+// adlarını döndürür. Bulunamayan değerler sıfır/boş döner.
+func driverCode(err error) driverError {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if code := driverFields(e); code.number != 0 || code.state != "" {
+			return code
+		}
+	}
+
+	if err == nil {
+		return driverError{}
+	}
+	msg := err.Error()
+	if m := mysqlMessageCode.FindStringSubmatch(msg); m != nil {
+		number, _ := strconv.Atoi(m[1])
+		return driverError{number: number, state: m[2]}
+	}
+	if m := sqlStateMessage.FindStringSubmatch(msg); m != nil {
+		return driverError{state: m[1]}
+	}
+	return driverError{}
+}
+
+// driverFields, tek bir hata değerinin Number, SQLState ve Code alanlarını;
+// lib/pq'nun Constraint/Column ve pgx'in ConstraintName/ColumnName alanlarını
+// okur.
+func driverFields(err error) driverError {
+	var code driverError
+	v := reflect.ValueOf(err)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return code
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return code
+	}
+
+	if f := v.FieldByName("Number"); f.IsValid() {
+		switch {
+		case f.CanUint():
+			code.number = int(f.Uint())
+		case f.CanInt():
+			code.number = int(f.Int())
+		}
+	}
+	if f := v.FieldByName("SQLState"); f.IsValid() {
+		code.state = fieldString(f)
+	}
+	if f := v.FieldByName("Code"); code.state == "" && f.IsValid() && f.Kind() == reflect.String {
+		code.state = f.String()
+	}
+	code.constraint = stringField(v, "ConstraintName", "Constraint")
+	code.column = stringField(v, "ColumnName", "Column")
+	return code
+}
+
+// stringField, struct'ın verilen adlardaki ilk dolu string alanını döndürür.
+func stringField(v reflect.Value, names ...string) string {
+	for _, name := range names {
+		if f := v.FieldByName(name); f.IsValid() && f.Kind() == reflect.String && f.String() != "" {
+			return f.String()
+		}
+	}
+	return ""
+}
+
+// fieldString, string veya [5]byte türündeki SQLSTATE alanını okur.
+func fieldString(f reflect.Value) string {
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Array:
+		if f.Type().Elem().Kind() != reflect.Uint8 {
+			return ""
+		}
+		b := make([]byte, f.Len())
+		for i := range b {
+			b[i] = byte(f.Index(i).Uint())
+		}
+		if b[0] == 0 {
+			return ""
+		}
+		return string(b)
+	}
+	return ""
+}
@@ -102,6 +102,67 @@ var (
 
 	// ErrQueryTimeout is returned when a query exceeds the context deadline.
 	ErrQueryTimeout = errors.New("fluentsql: query timeout exceeded")
+
+	// ErrLockOutsideTransaction is returned when a locking read is executed outside a transaction.
+	ErrLockOutsideTransaction = errors.New("fluentsql: row locks can only be used inside a transaction")
+
+	// ErrLockModifierWithoutLock is returned when SkipLocked/NoWait/Of is used before a lock is chosen.
+	ErrLockModifierWithoutLock = errors.New("fluentsql: lock modifier used without LockForUpdate or SharedLock")
+
+	// ErrNilSubquery is returned when JoinSub/UpdateFrom is called with a nil builder.
+	ErrNilSubquery = errors.New("fluentsql: subquery builder is nil")
+
+	// ErrEmptyOutcome is returned when an interceptor short-circuits a query without a result.
+	ErrEmptyOutcome = errors.New("fluentsql: interceptor returned no result")
+
+	// ErrReadOnlyTransaction is returned when a write is attempted in a read-only transaction.
+	ErrReadOnlyTransaction = errors.New("fluentsql: write operation in read-only transaction")
+
+	// ErrTransactionOptionsMismatch is returned when ReadOnlyTransaction or TransactionWithOptions
+	// would join a transaction from the context that was opened with different options.
+	ErrTransactionOptionsMismatch = errors.New("fluentsql: transaction options do not match the enclosing transaction")
+
+	// ErrExplainSkipped is reported in SlowQuery.ExplainErr when running EXPLAIN would have to
+	// wait for the connection still held by the slow query (open rows or an exhausted pool).
+	ErrExplainSkipped = errors.New("fluentsql: EXPLAIN skipped while the query holds the connection")
+
+	// ErrUnsupportedDialect is returned when a feature has no implementation for the database dialect.
+	ErrUnsupportedDialect = errors.New("fluentsql: unsupported dialect")
+
+	// ErrReturningAfterWrite is returned by an emulated InsertReturning when the row was
+	// inserted but its RETURNING values could not be read back. The write is not undone;
+	// retrying the insert creates a duplicate row.
+	ErrReturningAfterWrite = errors.New("fluentsql: row inserted but RETURNING values could not be read")
+)
+
+// Database error categories. Driver errors are classified by the grammar and
+// wrapped in a DatabaseError, so errors.Is(err, ErrUniqueViolation) works
+// regardless of the underlying driver.
+var (
+	// ErrUniqueViolation is matched when a unique index or primary key is violated.
+	ErrUniqueViolation = errors.New("fluentsql: unique constraint violation")
+
+	// ErrForeignKeyViolation is matched when a foreign key constraint is violated.
+	ErrForeignKeyViolation = errors.New("fluentsql: foreign key constraint violation")
+
+	// ErrNotNullViolation is matched when NULL is written to a NOT NULL column.
+	ErrNotNullViolation = errors.New("fluentsql: not null constraint violation")
+
+	// ErrCheckViolation is matched when a CHECK constraint is violated.
+	ErrCheckViolation = errors.New("fluentsql: check constraint violation")
+
+	// ErrDeadlock is matched when the database aborts a statement to resolve a deadlock.
+	ErrDeadlock = errors.New("fluentsql: deadlock detected")
+
+	// ErrLockTimeout is matched when a lock could not be acquired in time (or with NOWAIT).
+	ErrLockTimeout = errors.New("fluentsql: lock wait timeout")
+
+	// ErrSerialization is matched when a serializable transaction cannot be committed.
+	ErrSerialization = errors.New("fluentsql: serialization failure")
+
+	// ErrConnectionLost is matched when the server drops or refuses the connection
+	// (shutdown, killed session, SQLSTATE class 08).
+	ErrConnectionLost = errors.New("fluentsql: connection lost")
 )
 
 
@@ -149,6 +210,67 @@ func NewQueryError(op, table, sql string, err error) *QueryError {
 	}
 }
 
+// Class returns the metrics error class of the underlying error (see ErrorClass).
+// Metrik etiketlerinde kullanılacak düşük kardinaliteli hata sınıfını verir.
+func (e *QueryError) Class() string {
+	return ErrorClass(e.Err)
+}
+
+// Constraint returns the violated constraint or index name, if known.
+// Sınıflandırılmış bir DatabaseError yoksa boş döner.
+func (e *QueryError) Constraint() string {
+	var dbErr *DatabaseError
+	if errors.As(e.Err, &dbErr) {
+		return dbErr.Constraint
+	}
+	return ""
+}
+
+// Column returns the column that caused the violation, if known.
+// Sınıflandırılmış bir DatabaseError yoksa boş döner.
+func (e *QueryError) Column() string {
+	var dbErr *DatabaseError
+	if errors.As(e.Err, &dbErr) {
+		return dbErr.Column
+	}
+	return ""
+}
+
+
+// -------------------------------------------------------------------------------
+// 🏷 DatabaseError
+// -------------------------------------------------------------------------------
+// - Amaç: Sürücü hatasını veritabanından bağımsız bir kategoriyle işaretlemek.
+// - Neden var?: MySQL 1062 ile PostgreSQL 23505 aynı şeyi söyler; çağıran kod
+//   sürücü numaralarını bilmek zorunda kalmamalıdır.
+// - Kullanım: Grammar.ClassifyError tarafından tanınan sürücü hataları otomatik
+//   olarak sarılır. errors.Is(err, ErrUniqueViolation) kategoriyi, errors.As
+//   ise constraint/sütun bilgisini verir. Orijinal sürücü hatası Unwrap ile
+//   erişilebilir kalır.
+// ------------------------------------------------------------------------------
+type DatabaseError struct {
+	Kind       error  // ErrUniqueViolation, ErrDeadlock ...
+	Constraint string // Violated constraint or index name (may be empty)
+	Column     string // Offending column (may be empty)
+	Err        error  // Underlying driver error
+}
+
+// Error implements the error interface.
+// Sürücünün mesajı olduğu gibi korunur.
+func (e *DatabaseError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying driver error.
+func (e *DatabaseError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows errors.Is() to match against the category sentinel.
+func (e *DatabaseError) Is(target error) bool {
+	return target == e.Kind
+}
+
 
 // -------------------------------------------------------------------------------
 // 🏷 ValidationError
@@ -72,12 +72,19 @@ var (
 // Bu yapı; "Salt bağlantı" → "Akıllı ORM çekirdeği" dönüşümünün temel taşıdır.
 // ---------------------------------------------------------------------
 type DB struct {
-	*sql.DB                 // Standart Go DB nesnesi gömülü olarak bulunur.
-	grammar dialect.Grammar // SQL cümle yapısını oluşturur (MySQL / PostgreSQL / SQLite vb.)
-	scanner Scanner         // DB satırlarını struct'lara tarayıp dönüştüren bileşen.
-	logger  Logger          // İsteğe bağlı kayıtlama sistemi, debug durumunda detay sağlar.
-	debug   bool            // Sorgular loglansın mı? Geliştirici modu açık mı?
-	prefix  string          // Tablo adlarının önüne otomatik eklenebilen global prefix.
+	*sql.DB                     // Standart Go DB nesnesi gömülü olarak bulunur.
+	grammar     dialect.Grammar // SQL cümle yapısını oluşturur (MySQL / PostgreSQL / SQLite vb.)
+	scanner     Scanner         // DB satırlarını struct'lara tarayıp dönüştüren bileşen.
+	logger      Logger          // İsteğe bağlı kayıtlama sistemi, debug durumunda detay sağlar.
+	debug       bool            // Sorgular loglansın mı? Geliştirici modu açık mı?
+	prefix      string          // Tablo adlarının önüne otomatik eklenebilen global prefix.
+	redact      []string        // Loglarda değeri maskelenecek kolon kalıpları.
+	unprefixed  []string        // Prefix uygulanmayacak paylaşımlı tablolar.
+	explainSlow bool            // Yavaş sorgular için EXPLAIN planı alınsın mı?
+
+	interceptors []Interceptor // Sorgu yürütmesini saran middleware zinciri.
+	cluster      *Cluster      // Okumaların yönlendirileceği replica kümesi (opsiyonel).
+	retry        RetryPolicy   // Transaction ve tekil yazmalar için yeniden deneme politikası.
 }
 
 // NewDB -> DB sarmalayıcısının oluşturulduğu yerdir.
@@ -92,6 +99,7 @@ func NewDB(db *sql.DB, opts ...Option) *DB {
 		logger:  NopLogger{},
 		debug:   false,
 		prefix:  "",
+		redact:  DefaultRedactedColumns,
 	}
 
 	// Kullanıcı tarafından verilen opsiyonlar DB yapılandırmasını değiştirir.
@@ -130,6 +138,11 @@ func (d *DB) TablePrefix() string {
 	return d.prefix
 }
 
+// UnprefixedTables -> WithUnprefixedTables ile önek dışında bırakılan tabloları döndürür.
+func (d *DB) UnprefixedTables() []string {
+	return d.unprefixed
+}
+
 // IsDebug -> Debug modunda mıyız? Sorgular loglanacak mı? Bilgi verir.
 func (d *DB) IsDebug() bool {
 	return d.debug
@@ -139,26 +152,74 @@ func (d *DB) IsDebug() bool {
 // Bu fonksiyon, sorgu yazımının ilk adımıdır. Zincirin başlangıç halkasıdır.
 // ---------------------------------------------------------------------
 func (d *DB) Table(name string) *Builder {
-	return NewBuilder(d.DB, d.grammar, d.scanner).Table(name)
+	b := NewBuilder(d.DB, d.grammar, d.scanner).Table(name)
+	b.logger, b.debug, b.redact = d.logger, d.debug, d.redact
+	b.prefix, b.unprefixed = d.prefix, d.unprefixed
+	b.interceptors = d.interceptors
+	b.cluster, b.retry = d.cluster, d.retry
+	return b
+}
+
+// ExecStatement -> Builder dışında üretilen bir ifadeyi (ör. şema builder'ının DDL'i)
+// Builder sorgularıyla aynı yoldan çalıştırır: interceptor zinciri, logger,
+// yavaş sorgu raporu, tracing ve metrikler uygulanır. op ve table, QueryInfo'nun
+// Operation ve Table alanlarına yazılır.
+// ---------------------------------------------------------------------
+func (d *DB) ExecStatement(ctx context.Context, op, table, query string, args ...any) (sql.Result, error) {
+	info := QueryInfo{
+		Operation: op,
+		Method:    MethodExec,
+		Table:     table,
+		Dialect:   d.grammar.Name(),
+		SQL:       query,
+		Args:      args,
+	}
+	out, err := runQuery(ctx, d.DB, d.grammar, d.interceptors, d.logger, d.debug, info)
+	return out.Result, err
 }
 
 // BeginTx -> Manuel transaction başlatır. Bağlantıya güvenip işi tek adımda yapmak yerine,
 // adım adım ilerlemek isteyen geliştiriciler için kontrollü güç sunar.
 // ---------------------------------------------------------------------
 func (d *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Transaction, error) {
-	tx, err := d.DB.BeginTx(ctx, opts)
+	t := &Transaction{
+		pool:       d.DB,
+		ctx:        ctx,
+		grammar:    d.grammar,
+		scanner:    d.scanner,
+		logger:     d.logger,
+		debug:      d.debug,
+		prefix:     d.prefix,
+		redact:     d.redact,
+		unprefixed: d.unprefixed,
+		closed:     false,
+
+		interceptors: d.interceptors,
+	}
+
+	// Salt okunur transaction'lar cluster tanımlıysa replica'da açılır
+	pool := d.DB
+	if opts != nil {
+		t.isolation, t.readOnly = opts.Isolation, opts.ReadOnly
+		if opts.ReadOnly && d.cluster != nil {
+			pool = d.cluster.Reader()
+		}
+	}
+
+	// Begin de interceptor zincirinden geçer; zincirin verdiği context saklanır
+	err := t.lifecycle(ctx, "begin", func(ctx context.Context) error {
+		tx, err := pool.BeginTx(ctx, opts)
+		t.tx, t.ctx = tx, ctx
+		return err
+	})
+	if err == nil && t.tx == nil {
+		err = ErrEmptyOutcome
+	}
 	if err != nil {
 		return nil, WrapError("begin transaction", err)
 	}
-	return &Transaction{
-		tx:      tx,
-		grammar: d.grammar,
-		scanner: d.scanner,
-		logger:  d.logger,
-		debug:   d.debug,
-		prefix:  d.prefix,
-		closed:  false,
-	}, nil
+	t.ctx = WithTx(t.ctx, t)
+	return t, nil
 }
 
 // Begin -> Varsayılan ayarlarla transaction başlatır. Hızlı kullanım için kısayoldur.
@@ -170,9 +231,17 @@ func (d *DB) Begin() (*Transaction, error) {
 // Transaction -> Verilen fonksiyon içerisinde otomatik transaction yönetimi sağlar.
 // Başarılı olursa commit, hata veya panic durumunda rollback yapar.
 // Laravel `DB::transaction()` davranışına doğrudan bir karşılıktır.
+// WithRetryPolicy tanımlıysa geçici hatalarda fn yeni bir transaction ile tekrar çalışır.
+// ctx açık bir transaction taşıyorsa (WithTx) yeni transaction açılmaz; fn o
+// transaction içinde savepoint'li iç içe bir kapsamda çalışır.
 // ---------------------------------------------------------------------
 func (d *DB) Transaction(ctx context.Context, fn func(*Transaction) error) error {
-	tx, err := d.BeginTx(ctx, nil)
+	return d.TransactionWithRetry(ctx, d.retry, fn)
+}
+
+// transaction -> Tek bir transaction denemesini verilen seçeneklerle yürütür.
+func (d *DB) transaction(ctx context.Context, opts *sql.TxOptions, fn func(*Transaction) error) error {
+	tx, err := d.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -180,14 +249,14 @@ func (d *DB) Transaction(ctx context.Context, fn func(*Transaction) error) error
 	// Panic güvenliği → Transaction içi kod hata fırlatırsa rollback yapılır.
 	defer func() {
 		if p := recover(); p != nil {
-			_ = tx.Rollback()
+			_ = tx.rollback(panicError(p))
 			panic(p)
 		}
 	}()
 
 	// Kullanıcı fonksiyonunu çalıştır
 	if err := fn(tx); err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
+		if rbErr := tx.rollback(err); rbErr != nil {
 			return WrapError("rollback after error", rbErr)
 		}
 		return err
@@ -197,11 +266,19 @@ func (d *DB) Transaction(ctx context.Context, fn func(*Transaction) error) error
 	return tx.Commit()
 }
 
-// Close -> Veritabanı bağlantısını kapatır.
+// Close -> Veritabanı bağlantısını kapatır. Cluster tanımlıysa replica'lar da kapatılır.
 func (d *DB) Close() error {
+	if d.cluster != nil {
+		return d.cluster.Close()
+	}
 	return d.DB.Close()
 }
 
+// Cluster -> WithCluster ile bağlanan replica kümesini döndürür (yoksa nil).
+func (d *DB) Cluster() *Cluster {
+	return d.cluster
+}
+
 // Ping -> Bağlantı canlı mı? Kontrol eder.
 func (d *DB) Ping(ctx context.Context) error {
 	return d.DB.PingContext(ctx)
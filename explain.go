@@ -0,0 +1,297 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+//  EXPLAIN ve Yavaş Sorgu Tespiti
+//
+//  Builder.Explain, builder'ın üreteceği SELECT için veritabanının çalışma
+//  planını alır ve ayrıştırır. WithSlowQueryThreshold ise eşiği aşan her
+//  sorguyu bir handler'a bildirir; WithSlowQueryExplain açıksa aynı SQL ve
+//  bağlamalarla EXPLAIN çalıştırılıp plan da handler'a verilir.
+//
+//  Plan, grammar'ın CompileExplain ile ürettiği JSON çıktısından okunur
+//  (MySQL/MariaDB "EXPLAIN FORMAT=JSON", PostgreSQL "EXPLAIN (FORMAT JSON)").
+//  Ham belge (Raw) ve ayrıştırılmış ağaç (Tree) her zaman saklanır; sık
+//  kullanılan alanlar (maliyet, tablo erişim türleri) ayrıca çıkarılır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ExplainPlan, bir sorgunun ayrıştırılmış çalışma planıdır.
+type ExplainPlan struct {
+	SQL    string         // EXPLAIN edilen sorgu
+	Raw    string         // Veritabanının döndürdüğü ham JSON plan
+	Tree   map[string]any // Ayrıştırılmış plan belgesi
+	Cost   float64        // Tahmini sorgu maliyeti (MySQL query_cost, PostgreSQL "Total Cost")
+	Tables []ExplainTable // Plandaki tablo erişimleri (join sırası korunur)
+}
+
+// ExplainTable, plandaki tek bir tablo erişimini özetler.
+type ExplainTable struct {
+	Name         string   // Tablo adı veya alias
+	AccessType   string   // MySQL: "ALL", "index", "range", "ref" ...; PostgreSQL: "Seq Scan", "Index Scan" ...
+	Key          string   // Kullanılan index (yoksa boş)
+	PossibleKeys []string // Aday index'ler (yalnızca MySQL)
+	Rows         int64    // Tarama başına incelenen tahmini satır sayısı
+	Filtered     float64  // Koşulla süzülen satır yüzdesi (yalnızca MySQL)
+}
+
+// FullScans, index kullanmadan tamamı taranan (MySQL access_type "ALL",
+// PostgreSQL "Seq Scan") tabloların adlarını döndürür.
+func (p *ExplainPlan) FullScans() []string {
+	var tables []string
+	for _, t := range p.Tables {
+		if t.AccessType == "ALL" || t.AccessType == "Seq Scan" {
+			tables = append(tables, t.Name)
+		}
+	}
+	return tables
+}
+
+// Explain, builder'ın üreteceği SELECT sorgusunun çalışma planını döndürür.
+// Sorgu çalıştırılmaz; yalnızca planı alınır.
+//
+// Örnek:
+//
+//	plan, err := db.Table("orders").Where("status", "=", "open").Explain(ctx)
+//	if len(plan.FullScans()) > 0 { ... }
+func (b *Builder) Explain(ctx context.Context) (*ExplainPlan, error) {
+	if b.executor == nil {
+		return nil, ErrNoExecutor
+	}
+
+	sqlStr, args, err := b.sensitive().ToSelectSQL()
+	if err != nil {
+		return nil, err
+	}
+	explainSQL, err := b.grammar.CompileExplain(sqlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw string
+	row, err := b.queryRowContext(ctx, "explain", explainSQL, args)
+	if err != nil {
+		return nil, NewQueryError("explain", b.table, explainSQL, err)
+	}
+	if err := row.Scan(&raw); err != nil {
+		return nil, NewQueryError("explain", b.table, explainSQL, classifyError(b.grammar, err))
+	}
+
+	return parseExplain(sqlStr, raw)
+}
+
+// SlowQuery, eşiği aşan bir sorgunun bilgisidir.
+type SlowQuery struct {
+	QueryInfo
+
+	Duration   time.Duration // Sorgunun süresi
+	Err        error         // Sorgunun hatası (varsa)
+	Plan       *ExplainPlan  // WithSlowQueryExplain açıksa çalışma planı
+	ExplainErr error         // EXPLAIN alınamadıysa nedeni
+}
+
+// SlowQueryHandler, yavaş sorguları işleyen fonksiyondur. Sorguyu çalıştıran
+// goroutine'de, sonuç çağırana dönmeden önce çağrılır; uzun sürecek işler
+// (ağ, disk) handler içinde ayrı bir goroutine'e devredilmelidir.
+type SlowQueryHandler func(ctx context.Context, q SlowQuery)
+
+// slowQueryInterceptor, eşiği aşan sorguları handler'a bildiren interceptor'dır.
+// EXPLAIN'in hangi bağlantıda çalışacağı explainSlowQuery'de belirlenir.
+func slowQueryInterceptor(d *DB, threshold time.Duration, handler SlowQueryHandler) Interceptor {
+	return func(ctx context.Context, q QueryInfo, next QueryHandler) (QueryOutcome, error) {
+		if q.Method == MethodTx || q.Operation == "explain" {
+			return next(ctx, q)
+		}
+
+		start := time.Now()
+		out, err := next(ctx, q)
+		elapsed := time.Since(start)
+		if elapsed < threshold {
+			return out, err
+		}
+
+		slow := SlowQuery{QueryInfo: q, Duration: elapsed, Err: err}
+		if d.explainSlow {
+			slow.Plan, slow.ExplainErr = d.explainSlowQuery(ctx, q, out)
+		}
+		handler(ctx, slow)
+
+		return out, err
+	}
+}
+
+// explainSlowQuery, yavaş sorgunun planını sorguyu taşıyan bağlantıyı
+// bloklamadan alır.
+//
+// Transaction içindeki sorgular transaction'ın kendi bağlantısında EXPLAIN
+// edilir: plan transaction'ın gördüğü veriyle alınır ve havuzdan ikinci bir
+// bağlantı istenmez (SQLite'ta MaxOpenConns(1) ile bu kilitlenmeye yol
+// açardı). Query/QueryRow sonuçları henüz okunmamışken bağlantı meşguldür;
+// bu durumda ve havuzda boş bağlantı kalmadığında EXPLAIN atlanır ve
+// ErrExplainSkipped döner.
+func (d *DB) explainSlowQuery(ctx context.Context, q QueryInfo, out QueryOutcome) (*ExplainPlan, error) {
+	if out.Rows != nil || out.Row != nil {
+		if q.Tx != nil {
+			return nil, ErrExplainSkipped
+		}
+		if stats := d.DB.Stats(); stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections {
+			return nil, ErrExplainSkipped
+		}
+	}
+
+	explainSQL, err := d.grammar.CompileExplain(q.SQL)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *sql.Row
+	if q.Tx != nil {
+		row = q.Tx.tx.QueryRowContext(ctx, explainSQL, unwrapSensitive(q.Args)...)
+	} else {
+		row = d.DB.QueryRowContext(ctx, explainSQL, unwrapSensitive(q.Args)...)
+	}
+
+	var raw string
+	if err := row.Scan(&raw); err != nil {
+		return nil, WrapError("explain", err)
+	}
+	return parseExplain(q.SQL, raw)
+}
+
+// Explain, ham bir SELECT sorgusunun çalışma planını döndürür. Sorgu
+// çalıştırılmaz; EXPLAIN interceptor zincirini atlayarak doğrudan bağlantı
+// havuzunda çalışır.
+//
+// Örnek:
+//
+//	plan, err := db.Explain(ctx, "SELECT * FROM orders WHERE status = ?", "open")
+func (d *DB) Explain(ctx context.Context, query string, args ...any) (*ExplainPlan, error) {
+	return d.explain(ctx, query, args)
+}
+
+// explain, verilen SQL'in planını interceptor zincirini atlayarak doğrudan
+// bağlantı havuzu üzerinde alır.
+func (d *DB) explain(ctx context.Context, query string, args []any) (*ExplainPlan, error) {
+	explainSQL, err := d.grammar.CompileExplain(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw string
+	if err := d.DB.QueryRowContext(ctx, explainSQL, unwrapSensitive(args)...).Scan(&raw); err != nil {
+		return nil, WrapError("explain", err)
+	}
+	return parseExplain(query, raw)
+}
+
+// parseExplain, JSON plan çıktısını ExplainPlan'a çevirir. PostgreSQL planı
+// tek elemanlı bir dizi olarak döndürür; Tree bu elemanı tutar.
+func parseExplain(query, raw string) (*ExplainPlan, error) {
+	plan := &ExplainPlan{SQL: query, Raw: raw}
+
+	var doc any
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return nil, WrapError("parse explain plan", err)
+	}
+	if list, ok := doc.([]any); ok && len(list) > 0 {
+		doc = list[0]
+	}
+	tree, ok := doc.(map[string]any)
+	if !ok {
+		return nil, WrapError("parse explain plan", fmt.Errorf("unexpected plan document %.40q", raw))
+	}
+	plan.Tree = tree
+
+	if block, ok := plan.Tree["query_block"].(map[string]any); ok {
+		if cost, ok := block["cost_info"].(map[string]any); ok {
+			plan.Cost = explainNumber(cost["query_cost"])
+		}
+	}
+	if root, ok := plan.Tree["Plan"].(map[string]any); ok {
+		plan.Cost = explainNumber(root["Total Cost"])
+	}
+	collectExplainTables(plan.Tree, plan)
+
+	return plan, nil
+}
+
+// collectExplainTables, plan ağacını dolaşarak "table_name" içeren her düğümü
+// ExplainTable olarak ekler. Diziler sırayla, nesne alanları ise
+// deterministik olması için ada göre sıralı gezilir.
+func collectExplainTables(node any, plan *ExplainPlan) {
+	switch v := node.(type) {
+	case map[string]any:
+		if name, ok := v["table_name"].(string); ok {
+			t := ExplainTable{
+				Name:     name,
+				Filtered: explainNumber(v["filtered"]),
+			}
+			t.AccessType, _ = v["access_type"].(string)
+			t.Key, _ = v["key"].(string)
+			if keys, ok := v["possible_keys"].([]any); ok {
+				for _, k := range keys {
+					if s, ok := k.(string); ok {
+						t.PossibleKeys = append(t.PossibleKeys, s)
+					}
+				}
+			}
+			// MySQL: rows_examined_per_scan, MariaDB: rows
+			rows, ok := v["rows_examined_per_scan"]
+			if !ok {
+				rows = v["rows"]
+			}
+			t.Rows = int64(explainNumber(rows))
+			plan.Tables = append(plan.Tables, t)
+		} else if relation, ok := v["Relation Name"].(string); ok {
+			// PostgreSQL plan düğümü
+			t := ExplainTable{
+				Name: relation,
+				Rows: int64(explainNumber(v["Plan Rows"])),
+			}
+			if alias, ok := v["Alias"].(string); ok {
+				t.Name = alias
+			}
+			t.AccessType, _ = v["Node Type"].(string)
+			t.Key, _ = v["Index Name"].(string)
+			plan.Tables = append(plan.Tables, t)
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			collectExplainTables(v[k], plan)
+		}
+	case []any:
+		for _, item := range v {
+			collectExplainTables(item, plan)
+		}
+	}
+}
+
+// explainNumber, planlarda sayı veya metin ("100.00") olarak gelen değerleri okur.
+func explainNumber(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case string:
+		f, _ := strconv.ParseFloat(n, 64)
+		return f
+	}
+	return 0
+}
@@ -20,6 +20,11 @@ const Version = "0.1.0-alpha"
 // Connect, verilen driver ve veri kaynağıyla yeni bir veritabanı bağlantısı oluşturur ve DB örneğini döndürür.
 // Bağlantının doğruluğunu kontrol eder ve hata oluşursa WrapError ile sarar.
 //
+// Sorgu grameri sürücü adından seçilir (mysql, mariadb, postgres, pgx,
+// sqlite, sqlite3). Tanınmayan sürücülerde MySQL grameri kullanılır; özel
+// sürücüler için WithGrammar verilmelidir. Açıkça verilen WithGrammar her
+// zaman önceliklidir.
+//
 // Örnek:
 //
 //	db, err := fluentsql.Connect("mysql", "user:pass@tcp(localhost:3306)/dbname")
@@ -39,6 +44,11 @@ func Connect(driverName, dataSourceName string, opts ...Option) (*DB, error) {
 		return nil, WrapError("ping", err)
 	}
 
+	if grammar, ok := dialect.ForDriver(driverName); ok {
+		// Sürücünün grameri varsayılandır; açıkça verilen seçenekler onu ezebilir
+		opts = append([]Option{WithGrammar(grammar)}, opts...)
+	}
+
 	return NewDB(sqlDB, opts...), nil
 }
 
@@ -61,6 +71,11 @@ func ConnectWithConfig(cfg *Config, opts ...Option) (*DB, error) {
 		cfg = DefaultConfig()
 	}
 
+	if cfg.Prefix != "" {
+		// Config önekini varsayılan kabul et; açıkça verilen seçenekler onu ezebilir
+		opts = append([]Option{WithTablePrefix(cfg.Prefix)}, opts...)
+	}
+
 	dsn := cfg.DSN()
 	db, err := Connect(cfg.Driver, dsn, opts...)
 	if err != nil {
@@ -106,7 +121,9 @@ func New(opts ...Option) *Builder {
 		opt(d)
 	}
 
-	return NewBuilder(nil, d.grammar, d.scanner)
+	b := NewBuilder(nil, d.grammar, d.scanner)
+	b.prefix, b.unprefixed = d.prefix, d.unprefixed
+	return b
 }
 
 // Table, yeni bir Builder oluşturup tablo adını ayarlamak için kısayoldur.
@@ -146,3 +163,61 @@ func NewRaw(sql string, bindings ...any) Raw {
 func (r Raw) String() string {
 	return r.SQL
 }
+
+// CompileExpression, dialect.Expression arayüzünü uygular.
+// Raw ifade olduğu gibi SQL'e gömülür, bağlamaları argümanlara eklenir.
+//
+// Örnek:
+//
+//	db.Table("posts").Where("id", "=", 1).Update(map[string]any{
+//	    "published_at": fluentsql.NewRaw("NOW()"),
+//	})
+func (r Raw) CompileExpression(func(string) (string, error)) (string, []any, error) {
+	return r.SQL, r.Bindings, nil
+}
+
+// Expr, bir kolon üzerinde aritmetik işlem yapan güvenli ifadedir.
+// Raw'dan farkı; kolon adının doğrulanıp gramer ile sarılması ve
+// operatörün beyaz listeden (+, -, *, /) seçilmesidir.
+type Expr struct {
+	Column   string
+	Operator string
+	Value    any
+}
+
+// NewExpr, yeni bir aritmetik kolon ifadesi oluşturur.
+//
+// Örnek:
+//
+//	db.Table("products").Where("id", "=", 7).Update(map[string]any{
+//	    "price": fluentsql.NewExpr("price", "*", 1.1), // `price` = `price` * ?
+//	})
+func NewExpr(column, operator string, value any) Expr {
+	return Expr{
+		Column:   column,
+		Operator: operator,
+		Value:    value,
+	}
+}
+
+// CompileExpression, dialect.Expression arayüzünü uygular.
+func (e Expr) CompileExpression(wrap func(string) (string, error)) (string, []any, error) {
+	switch e.Operator {
+	case "+", "-", "*", "/":
+	default:
+		return "", nil, NewValidationError("operator", e.Operator, "arithmetic expression operator must be one of + - * /")
+	}
+
+	column, err := wrap(e.Column)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return column + " " + e.Operator + " ?", []any{e.Value}, nil
+}
+
+// Compile-time kontrolü: Raw ve Expr, grammar tarafından ifade olarak tanınır.
+var (
+	_ dialect.Expression = Raw{}
+	_ dialect.Expression = Expr{}
+)
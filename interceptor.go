@@ -0,0 +1,159 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+// -----------------------------------------------------------------------------
+//  Interceptor Zinciri — Sorgu Yürütme Etrafında Middleware
+//
+//  Builder ve Transaction üzerinden yapılan her ExecContext / QueryContext /
+//  QueryRowContext çağrısı, WithInterceptor ile kaydedilen fonksiyonlardan
+//  oluşan bir zincirden geçer. Zincir, HTTP middleware'lerine benzer şekilde
+//  çalışır: ilk kaydedilen interceptor en dışta yer alır.
+//
+//  Bir interceptor;
+//   • QueryInfo'yu değiştirip next'e vererek sorguyu değiştirebilir,
+//   • next'i hiç çağırmadan kendi sonucunu dönerek sorguyu kısa devre yapabilir,
+//   • next'in sonucunu ve süresini gözlemleyebilir (tracing, metrik, audit).
+//
+//  Zincirin en içinde sürücü çağrısı ve loglama yer alır; dolayısıyla loglar
+//  interceptor'ların değiştirdiği nihai SQL'i gösterir.
+//
+//  Transaction'ın begin/commit/rollback adımları da MethodTx ile aynı zincirden
+//  geçer. "begin" sırasında next'e verilen context, Transaction.Context() olarak
+//  saklanır; tracing gibi entegrasyonlar transaction kapsamını buradan izler.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// QueryMethod, sorgunun hangi executor metoduyla çalıştırılacağını belirtir.
+type QueryMethod string
+
+const (
+	// MethodExec, satır döndürmeyen ifadeler içindir (ExecContext).
+	MethodExec QueryMethod = "exec"
+	// MethodQuery, çok satırlı sonuç döndüren ifadeler içindir (QueryContext).
+	MethodQuery QueryMethod = "query"
+	// MethodQueryRow, tek satırlık sonuç döndüren ifadeler içindir (QueryRowContext).
+	MethodQueryRow QueryMethod = "query_row"
+	// MethodTx, SQL çalıştırmayan transaction yaşam döngüsü olayları içindir
+	// ("begin", "commit", "rollback"). Bu olaylarda SQL ve Args boştur,
+	// QueryOutcome kullanılmaz.
+	MethodTx QueryMethod = "tx"
+)
+
+// QueryInfo, interceptor'lara verilen sorgu tanımıdır.
+//
+// Operation değerleri:
+//   - Builder: "select", "insert", "update", "delete", "count", "exists"
+//   - Ham Transaction sorguları: "exec", "query"
+//   - Savepoint'ler: "savepoint", "rollback_to_savepoint", "release_savepoint"
+//   - Transaction yaşam döngüsü (MethodTx): "begin", "commit", "rollback"
+//   - Şema builder'ı (ExecStatement): "create_table", "alter_table", "drop_table", "rename_table"
+type QueryInfo struct {
+	Operation     string       // Yukarıdaki işlem adlarından biri
+	Method        QueryMethod  // Çalıştırma yöntemi
+	Table         string       // Hedef tablo (ham Transaction sorgularında boş)
+	Dialect       string       // Grammar adı ("mysql", "mariadb", "postgres", "sqlite")
+	SQL           string       // Çalıştırılacak SQL
+	Args          []any        // Bağlamalar (Sensitive değerler sarmalanmış hâlde)
+	InTransaction bool         // Sorgu bir transaction içinde mi?
+	Tx            *Transaction // Sorgunun ait olduğu transaction (yoksa nil)
+}
+
+// RedactedArgs, Sensitive değerleri maskelenmiş bağlamaları döndürür.
+// Audit veya tracing gibi dışarıya veri yazan interceptor'lar bunu kullanmalıdır.
+func (q QueryInfo) RedactedArgs() []any {
+	return maskSensitive(q.Args)
+}
+
+// QueryOutcome, bir sorgunun sonucunu taşır. Method'a göre yalnızca ilgili alan doludur.
+type QueryOutcome struct {
+	Result sql.Result // MethodExec
+	Rows   *sql.Rows  // MethodQuery
+	Row    *sql.Row   // MethodQueryRow
+}
+
+// QueryHandler, zincirdeki bir sonraki adımı temsil eder.
+type QueryHandler func(ctx context.Context, info QueryInfo) (QueryOutcome, error)
+
+// Interceptor, sorgu yürütmesini saran middleware fonksiyonudur.
+//
+// Örnek (yavaş sorgu ölçümü):
+//
+//	timing := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+//	    start := time.Now()
+//	    out, err := next(ctx, q)
+//	    metrics.Observe(q.Operation, q.Table, time.Since(start))
+//	    return out, err
+//	}
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(timing))
+type Interceptor func(ctx context.Context, info QueryInfo, next QueryHandler) (QueryOutcome, error)
+
+// chain, interceptor'ları terminal handler etrafına sarar.
+// İlk interceptor en dışta çalışır.
+func chain(interceptors []Interceptor, terminal QueryHandler) QueryHandler {
+	h := terminal
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		ic, next := interceptors[i], h
+		h = func(ctx context.Context, info QueryInfo) (QueryOutcome, error) {
+			return ic(ctx, info, next)
+		}
+	}
+	return h
+}
+
+// terminalHandler, zincirin en içindeki gerçek sürücü çağrısını yapar ve
+// sonucu Logger'a raporlar. Sensitive değerler burada açılır; sürücü hataları
+// interceptor'lara dönmeden önce grammar ile sınıflandırılır.
+func terminalHandler(executor QueryExecutor, grammar dialect.Grammar, logger Logger, debug bool) QueryHandler {
+	return func(ctx context.Context, info QueryInfo) (QueryOutcome, error) {
+		var out QueryOutcome
+		var err error
+
+		start := time.Now()
+		args := unwrapSensitive(info.Args)
+		switch info.Method {
+		case MethodExec:
+			out.Result, err = executor.ExecContext(ctx, info.SQL, args...)
+		case MethodQuery:
+			out.Rows, err = executor.QueryContext(ctx, info.SQL, args...)
+		default:
+			out.Row = executor.QueryRowContext(ctx, info.SQL, args...)
+			err = out.Row.Err()
+		}
+		logQuery(logger, debug, info.SQL, info.Args, time.Since(start), err)
+
+		return out, classifyError(grammar, err)
+	}
+}
+
+// runQuery, sorguyu interceptor zincirinden geçirerek çalıştırır ve
+// dönen sonucun seçilen yönteme uygun olduğunu doğrular.
+func runQuery(ctx context.Context, executor QueryExecutor, grammar dialect.Grammar, interceptors []Interceptor, logger Logger, debug bool, info QueryInfo) (QueryOutcome, error) {
+	out, err := chain(interceptors, terminalHandler(executor, grammar, logger, debug))(ctx, info)
+	if info.Method == MethodQueryRow && out.Row != nil {
+		// Row kendi hatasını taşır; hata Scan sırasında raporlanır ve
+		// Builder tarafından sınıflandırılır
+		return out, nil
+	}
+	if err != nil {
+		return out, err
+	}
+
+	switch {
+	case info.Method == MethodExec && out.Result == nil,
+		info.Method == MethodQuery && out.Rows == nil,
+		info.Method == MethodQueryRow && out.Row == nil:
+		return out, ErrEmptyOutcome
+	}
+	return out, nil
+}
@@ -0,0 +1,508 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+//  Şema İnceleme (Schema Introspection)
+//
+//  DB.Schema(), canlı veritabanının tablo, kolon, index ve foreign key
+//  bilgilerini dialect'ten bağımsız yapılar olarak döndürür. Migration'lar,
+//  kod üretici ve yönetim araçları kataloğu kendi başlarına sorgulamak yerine
+//  bu API'yi kullanır.
+//
+//   • MySQL/MariaDB : information_schema (geçerli veritabanı, DATABASE())
+//   • PostgreSQL    : information_schema (geçerli şema, current_schema());
+//                     index'ler için pg_index
+//   • SQLite        : sqlite_master ve pragma_table_info / pragma_index_list /
+//                     pragma_foreign_key_list
+//
+//  Tablo adları fiziksel adlardır; WithTablePrefix öneki uygulanmaz. Böylece
+//  Tables()'ın döndürdüğü adlar doğrudan Columns() gibi metotlara verilebilir.
+//  Katalog sorguları interceptor zincirini atlayarak primary bağlantı havuzunda
+//  çalışır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ColumnInfo, bir tablo kolonunun tanımıdır.
+type ColumnInfo struct {
+	Name          string
+	Type          string  // Küçük harfli temel tip: "varchar", "bigint", "character varying", "integer" ...
+	FullType      string  // Uzunluk ve niteleyicilerle tip: "varchar(255)", "int unsigned", "numeric(10,2)"
+	Nullable      bool    // NULL kabul ediyor mu
+	Default       *string // Varsayılan değer ifadesi; yoksa nil
+	PrimaryKey    bool    // Primary key'in parçası mı
+	AutoIncrement bool    // AUTO_INCREMENT, SERIAL/IDENTITY veya SQLite rowid alias'ı
+	Comment       string  // Kolon açıklaması (yalnızca MySQL)
+}
+
+// IndexInfo, bir index'in tanımıdır. Primary key de Primary alanı true olan
+// bir index olarak raporlanır.
+type IndexInfo struct {
+	Name    string
+	Columns []string // Index sırasıyla kolonlar; ifade index'lerinin ifadeleri dahil edilmez
+	Unique  bool
+	Primary bool
+}
+
+// ForeignKeyInfo, bir foreign key kısıtının tanımıdır.
+type ForeignKeyInfo struct {
+	Name       string // SQLite kısıt adlarını raporlamaz; orada boştur
+	Columns    []string
+	RefTable   string
+	RefColumns []string
+	OnDelete   string // "CASCADE", "SET NULL", "RESTRICT", "NO ACTION" ...
+	OnUpdate   string
+}
+
+// SchemaInspector, canlı şemayı sorgular. DB.Schema ile oluşturulur.
+type SchemaInspector struct {
+	db      *sql.DB
+	dialect string
+}
+
+// Schema, DB'nin grammar'ına göre bir şema inceleyici döndürür. Grammar
+// dialect'i bağlantıdan farklıysa (ör. varsayılan MySQL grammar'ı ile açılmış
+// bir SQLite bağlantısı) WithDialect ile belirtilmelidir.
+//
+// Örnek:
+//
+//	cols, err := db.Schema().Columns(ctx, "users")
+//	ok, err := db.Schema().WithDialect("sqlite").HasTable(ctx, "users")
+func (d *DB) Schema() *SchemaInspector {
+	return &SchemaInspector{db: d.DB, dialect: d.grammar.Name()}
+}
+
+// WithDialect, verilen sürücü veya dialect adıyla çalışan bir kopya döndürür.
+// Tanınan adlar: mysql, mariadb, postgres, postgresql, pgx, sqlite, sqlite3.
+func (s *SchemaInspector) WithDialect(name string) *SchemaInspector {
+	cp := *s
+	cp.dialect = name
+	return &cp
+}
+
+// Dialect, inceleyicinin normalize edilmiş dialect adını döndürür
+// ("mysql", "postgres" veya "sqlite"); tanınmıyorsa verilen ad döner.
+func (s *SchemaInspector) Dialect() string {
+	switch strings.ToLower(s.dialect) {
+	case "mysql", "mariadb":
+		return "mysql"
+	case "postgres", "postgresql", "pgx":
+		return "postgres"
+	case "sqlite", "sqlite3":
+		return "sqlite"
+	default:
+		return s.dialect
+	}
+}
+
+// Tables, geçerli veritabanındaki (PostgreSQL'de geçerli şemadaki) tabloları
+// ad sırasıyla döndürür. View'lar dahil edilmez.
+func (s *SchemaInspector) Tables(ctx context.Context) ([]string, error) {
+	var query string
+	switch s.Dialect() {
+	case "mysql":
+		query = "SELECT table_name FROM information_schema.tables " +
+			"WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' ORDER BY table_name"
+	case "postgres":
+		query = "SELECT table_name FROM information_schema.tables " +
+			"WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' ORDER BY table_name"
+	case "sqlite":
+		query = "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name"
+	default:
+		return nil, s.unsupported()
+	}
+
+	var tables []string
+	err := s.query(ctx, "", query, nil, func(rows *sql.Rows) error {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		tables = append(tables, name)
+		return nil
+	})
+	return tables, err
+}
+
+// HasTable, tablonun var olup olmadığını bildirir.
+func (s *SchemaInspector) HasTable(ctx context.Context, table string) (bool, error) {
+	var query string
+	switch s.Dialect() {
+	case "mysql":
+		query = "SELECT COUNT(*) FROM information_schema.tables " +
+			"WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' AND table_name = ?"
+	case "postgres":
+		query = "SELECT COUNT(*) FROM information_schema.tables " +
+			"WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' AND table_name = $1"
+	case "sqlite":
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	default:
+		return false, s.unsupported()
+	}
+
+	var n int
+	if err := s.db.QueryRowContext(ctx, query, table).Scan(&n); err != nil {
+		return false, NewQueryError("introspect", table, query, err)
+	}
+	return n > 0, nil
+}
+
+// HasColumn, tabloda kolonun var olup olmadığını bildirir. Karşılaştırma
+// büyük/küçük harfe duyarsızdır. Tablo yoksa false döner.
+func (s *SchemaInspector) HasColumn(ctx context.Context, table, column string) (bool, error) {
+	cols, err := s.Columns(ctx, table)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range cols {
+		if strings.EqualFold(c.Name, column) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Columns, tablonun kolonlarını tanım sırasıyla döndürür. Tablo yoksa boş
+// liste döner.
+func (s *SchemaInspector) Columns(ctx context.Context, table string) ([]ColumnInfo, error) {
+	switch s.Dialect() {
+	case "mysql":
+		return s.mysqlColumns(ctx, table)
+	case "postgres":
+		return s.postgresColumns(ctx, table)
+	case "sqlite":
+		return s.sqliteColumns(ctx, table)
+	default:
+		return nil, s.unsupported()
+	}
+}
+
+// Indexes, tablonun index'lerini ad sırasıyla döndürür.
+func (s *SchemaInspector) Indexes(ctx context.Context, table string) ([]IndexInfo, error) {
+	switch s.Dialect() {
+	case "mysql":
+		return s.groupIndexes(ctx, table,
+			"SELECT index_name, column_name, non_unique = 0, index_name = 'PRIMARY' "+
+				"FROM information_schema.statistics "+
+				"WHERE table_schema = DATABASE() AND table_name = ? AND column_name IS NOT NULL "+
+				"ORDER BY index_name, seq_in_index")
+	case "postgres":
+		return s.groupIndexes(ctx, table,
+			"SELECT i.relname, a.attname, ix.indisunique, ix.indisprimary "+
+				"FROM pg_index ix "+
+				"JOIN pg_class t ON t.oid = ix.indrelid "+
+				"JOIN pg_class i ON i.oid = ix.indexrelid "+
+				"JOIN pg_namespace n ON n.oid = t.relnamespace "+
+				"JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, ord) ON true "+
+				"JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum "+
+				"WHERE n.nspname = current_schema() AND t.relname = $1 "+
+				"ORDER BY i.relname, k.ord")
+	case "sqlite":
+		return s.sqliteIndexes(ctx, table)
+	default:
+		return nil, s.unsupported()
+	}
+}
+
+// ForeignKeys, tablonun foreign key kısıtlarını döndürür.
+func (s *SchemaInspector) ForeignKeys(ctx context.Context, table string) ([]ForeignKeyInfo, error) {
+	var query string
+	switch s.Dialect() {
+	case "mysql":
+		query = "SELECT k.constraint_name, k.column_name, k.referenced_table_name, k.referenced_column_name, " +
+			"r.delete_rule, r.update_rule " +
+			"FROM information_schema.key_column_usage k " +
+			"JOIN information_schema.referential_constraints r " +
+			"ON r.constraint_schema = k.constraint_schema AND r.table_name = k.table_name " +
+			"AND r.constraint_name = k.constraint_name " +
+			"WHERE k.table_schema = DATABASE() AND k.table_name = ? AND k.referenced_table_name IS NOT NULL " +
+			"ORDER BY k.constraint_name, k.ordinal_position"
+	case "postgres":
+		query = "SELECT k.constraint_name, k.column_name, u.table_name, u.column_name, " +
+			"r.delete_rule, r.update_rule " +
+			"FROM information_schema.referential_constraints r " +
+			"JOIN information_schema.key_column_usage k " +
+			"ON k.constraint_schema = r.constraint_schema AND k.constraint_name = r.constraint_name " +
+			"JOIN information_schema.key_column_usage u " +
+			"ON u.constraint_schema = r.unique_constraint_schema AND u.constraint_name = r.unique_constraint_name " +
+			"AND u.ordinal_position = k.position_in_unique_constraint " +
+			"WHERE k.table_schema = current_schema() AND k.table_name = $1 " +
+			"ORDER BY k.constraint_name, k.ordinal_position"
+	case "sqlite":
+		query = `SELECT id, "from", "table", "to", on_delete, on_update FROM pragma_foreign_key_list(?) ORDER BY id, seq`
+	default:
+		return nil, s.unsupported()
+	}
+
+	var fks []ForeignKeyInfo
+	var last string
+	err := s.query(ctx, table, query, []any{table}, func(rows *sql.Rows) error {
+		var name, col, refTable, onDelete, onUpdate string
+		var refCol sql.NullString // SQLite: hedef kolon verilmemişse NULL
+		if err := rows.Scan(&name, &col, &refTable, &refCol, &onDelete, &onUpdate); err != nil {
+			return err
+		}
+		if len(fks) == 0 || name != last {
+			fks = append(fks, ForeignKeyInfo{Name: name, RefTable: refTable, OnDelete: onDelete, OnUpdate: onUpdate})
+			last = name
+		}
+		fk := &fks[len(fks)-1]
+		fk.Columns = append(fk.Columns, col)
+		fk.RefColumns = append(fk.RefColumns, refCol.String)
+		return nil
+	})
+	if err != nil || s.Dialect() != "sqlite" {
+		return fks, err
+	}
+
+	// pragma_foreign_key_list kısıt adı yerine sıra numarası döndürür;
+	// "REFERENCES users" gibi kolonsuz referanslar hedefin primary key'ini gösterir
+	for i := range fks {
+		fks[i].Name = ""
+		if fks[i].RefColumns[0] != "" {
+			continue
+		}
+		cols, err := s.sqliteColumns(ctx, fks[i].RefTable)
+		if err != nil {
+			return nil, err
+		}
+		fks[i].RefColumns = fks[i].RefColumns[:0]
+		for _, c := range cols {
+			if c.PrimaryKey {
+				fks[i].RefColumns = append(fks[i].RefColumns, c.Name)
+			}
+		}
+	}
+	return fks, nil
+}
+
+// ---------------------------------------------------------------------------
+// Dialect'e özgü kolon sorguları
+// ---------------------------------------------------------------------------
+
+func (s *SchemaInspector) mysqlColumns(ctx context.Context, table string) ([]ColumnInfo, error) {
+	const query = "SELECT column_name, data_type, column_type, is_nullable, column_default, " +
+		"column_key, extra, column_comment " +
+		"FROM information_schema.columns " +
+		"WHERE table_schema = DATABASE() AND table_name = ? ORDER BY ordinal_position"
+
+	var cols []ColumnInfo
+	err := s.query(ctx, table, query, []any{table}, func(rows *sql.Rows) error {
+		var c ColumnInfo
+		var nullable, key, extra string
+		var def sql.NullString
+		if err := rows.Scan(&c.Name, &c.Type, &c.FullType, &nullable, &def, &key, &extra, &c.Comment); err != nil {
+			return err
+		}
+		c.Type = strings.ToLower(c.Type)
+		c.FullType = strings.ToLower(c.FullType)
+		c.Nullable = nullable == "YES"
+		c.PrimaryKey = key == "PRI"
+		c.AutoIncrement = strings.Contains(strings.ToLower(extra), "auto_increment")
+		if def.Valid {
+			c.Default = &def.String
+		}
+		cols = append(cols, c)
+		return nil
+	})
+	return cols, err
+}
+
+func (s *SchemaInspector) postgresColumns(ctx context.Context, table string) ([]ColumnInfo, error) {
+	const query = "SELECT c.column_name, c.data_type, c.character_maximum_length, c.numeric_precision, " +
+		"c.numeric_scale, c.is_nullable, c.column_default, c.is_identity, " +
+		"EXISTS (SELECT 1 FROM information_schema.table_constraints tc " +
+		"JOIN information_schema.key_column_usage k " +
+		"ON k.constraint_schema = tc.constraint_schema AND k.constraint_name = tc.constraint_name " +
+		"WHERE tc.table_schema = c.table_schema AND tc.table_name = c.table_name " +
+		"AND tc.constraint_type = 'PRIMARY KEY' AND k.column_name = c.column_name) " +
+		"FROM information_schema.columns c " +
+		"WHERE c.table_schema = current_schema() AND c.table_name = $1 ORDER BY c.ordinal_position"
+
+	var cols []ColumnInfo
+	err := s.query(ctx, table, query, []any{table}, func(rows *sql.Rows) error {
+		var c ColumnInfo
+		var length, precision, scale sql.NullInt64
+		var nullable, identity string
+		var def sql.NullString
+		if err := rows.Scan(&c.Name, &c.Type, &length, &precision, &scale, &nullable, &def, &identity, &c.PrimaryKey); err != nil {
+			return err
+		}
+		c.Type = strings.ToLower(c.Type)
+		c.FullType = c.Type
+		switch {
+		case length.Valid:
+			c.FullType = fmt.Sprintf("%s(%d)", c.Type, length.Int64)
+		case c.Type == "numeric" && precision.Valid:
+			c.FullType = fmt.Sprintf("numeric(%d,%d)", precision.Int64, scale.Int64)
+		}
+		c.Nullable = nullable == "YES"
+		c.AutoIncrement = identity == "YES" || strings.HasPrefix(def.String, "nextval(")
+		if def.Valid && !c.AutoIncrement {
+			c.Default = &def.String
+		}
+		cols = append(cols, c)
+		return nil
+	})
+	return cols, err
+}
+
+func (s *SchemaInspector) sqliteColumns(ctx context.Context, table string) ([]ColumnInfo, error) {
+	const query = `SELECT name, type, "notnull", dflt_value, pk FROM pragma_table_info(?) ORDER BY cid`
+
+	var cols []ColumnInfo
+	pkCount := 0
+	err := s.query(ctx, table, query, []any{table}, func(rows *sql.Rows) error {
+		var c ColumnInfo
+		var notNull, pk int
+		var def sql.NullString
+		if err := rows.Scan(&c.Name, &c.FullType, &notNull, &def, &pk); err != nil {
+			return err
+		}
+		c.FullType = strings.ToLower(c.FullType)
+		c.Type = c.FullType
+		if i := strings.IndexByte(c.Type, '('); i >= 0 {
+			c.Type = strings.TrimSpace(c.Type[:i])
+		}
+		c.PrimaryKey = pk > 0
+		c.Nullable = notNull == 0 && !c.PrimaryKey
+		if def.Valid {
+			c.Default = &def.String
+		}
+		if c.PrimaryKey {
+			pkCount++
+		}
+		cols = append(cols, c)
+		return nil
+	})
+
+	// Tek kolonlu INTEGER PRIMARY KEY, rowid'in takma adıdır ve otomatik artar
+	if pkCount == 1 {
+		for i := range cols {
+			if cols[i].PrimaryKey && cols[i].Type == "integer" {
+				cols[i].AutoIncrement = true
+			}
+		}
+	}
+	return cols, err
+}
+
+// ---------------------------------------------------------------------------
+// Index'ler
+// ---------------------------------------------------------------------------
+
+// groupIndexes, (index, kolon, unique, primary) satırlarını index'lere toplar.
+func (s *SchemaInspector) groupIndexes(ctx context.Context, table, query string) ([]IndexInfo, error) {
+	var indexes []IndexInfo
+	err := s.query(ctx, table, query, []any{table}, func(rows *sql.Rows) error {
+		var name, col string
+		var unique, primary bool
+		if err := rows.Scan(&name, &col, &unique, &primary); err != nil {
+			return err
+		}
+		if len(indexes) == 0 || indexes[len(indexes)-1].Name != name {
+			indexes = append(indexes, IndexInfo{Name: name, Unique: unique, Primary: primary})
+		}
+		idx := &indexes[len(indexes)-1]
+		idx.Columns = append(idx.Columns, col)
+		return nil
+	})
+	return indexes, err
+}
+
+// sqliteIndexes, pragma_index_list ve pragma_index_info ile index'leri okur.
+// Rowid alias'ı olan INTEGER PRIMARY KEY bir index olarak listelenmediğinden
+// "primary" adıyla eklenir.
+func (s *SchemaInspector) sqliteIndexes(ctx context.Context, table string) ([]IndexInfo, error) {
+	const list = `SELECT name, "unique", origin FROM pragma_index_list(?)`
+	const info = `SELECT name FROM pragma_index_info(?) ORDER BY seqno`
+
+	var indexes []IndexInfo
+	err := s.query(ctx, table, list, []any{table}, func(rows *sql.Rows) error {
+		var idx IndexInfo
+		var origin string
+		if err := rows.Scan(&idx.Name, &idx.Unique, &origin); err != nil {
+			return err
+		}
+		idx.Primary = origin == "pk"
+		indexes = append(indexes, idx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hasPrimary := false
+	for i := range indexes {
+		err := s.query(ctx, table, info, []any{indexes[i].Name}, func(rows *sql.Rows) error {
+			var col sql.NullString
+			if err := rows.Scan(&col); err != nil {
+				return err
+			}
+			if col.Valid {
+				indexes[i].Columns = append(indexes[i].Columns, col.String)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		hasPrimary = hasPrimary || indexes[i].Primary
+	}
+
+	if !hasPrimary {
+		cols, err := s.sqliteColumns(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		var pk []string
+		for _, c := range cols {
+			if c.PrimaryKey {
+				pk = append(pk, c.Name)
+			}
+		}
+		if len(pk) > 0 {
+			indexes = append(indexes, IndexInfo{Name: "primary", Columns: pk, Unique: true, Primary: true})
+		}
+	}
+
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i].Name < indexes[j].Name })
+	return indexes, nil
+}
+
+// ---------------------------------------------------------------------------
+// Yardımcılar
+// ---------------------------------------------------------------------------
+
+// query, katalog sorgusunu çalıştırır ve her satır için scan'i çağırır.
+func (s *SchemaInspector) query(ctx context.Context, table, query string, args []any, scan func(*sql.Rows) error) error {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return NewQueryError("introspect", table, query, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return NewQueryError("introspect", table, query, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return NewQueryError("introspect", table, query, err)
+	}
+	return nil
+}
+
+func (s *SchemaInspector) unsupported() error {
+	return fmt.Errorf("%w: %q", ErrUnsupportedDialect, s.dialect)
+}
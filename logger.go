@@ -0,0 +1,293 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+// -----------------------------------------------------------------------------
+//  Sorgu Loglama — Hazır Logger'lar ve Argüman Maskeleme
+//
+//  Builder ve Transaction üzerinden çalışan her ifade; SQL metni, bağlamaları,
+//  süresi ve hatasıyla birlikte DB'ye verilen Logger'a raporlanır. Debug modu
+//  açıkken tüm sorgular, kapalıyken yalnızca hatalı sorgular loglanır.
+//
+//  Loglara parola, token gibi değerlerin sızmaması için iki mekanizma vardır:
+//   • Kolon kuralları: WHERE/INSERT/UPDATE içinde adı hassas kalıplardan birini
+//     içeren kolonların değerleri otomatik olarak maskelenir (WithRedactedColumns).
+//   • Açık işaretleme: Sensitive(v) ile sarılan her değer, hangi kolona ait
+//     olursa olsun loglarda "[REDACTED]" olarak görünür.
+//
+//  Maskeleme yalnızca log tarafını etkiler; sürücüye her zaman ham değer gider.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// RedactedPlaceholder, maskelenen bağlamaların loglarda görünen hâlidir.
+const RedactedPlaceholder = "[REDACTED]"
+
+// DefaultRedactedColumns, değerleri varsayılan olarak maskelenen kolon kalıplarıdır.
+// Kolon adı (tablo niteleyicisi atılarak, küçük harfle) bu kalıplardan birini
+// içeriyorsa eşleşme sayılır: "password" → "password", "password_hash", "users.password".
+var DefaultRedactedColumns = []string{"password", "passwd", "secret", "token", "api_key", "apikey"}
+
+// ----------------------------------------------------------------------------
+// Sensitive
+// ----------------------------------------------------------------------------
+
+// SensitiveValue, loglarda maskelenmesi gereken bir bağlama değeridir.
+// driver.Valuer implementasyonu sayesinde ToSQL çıktısı doğrudan database/sql'e
+// verildiğinde de ham değer kullanılır.
+type SensitiveValue struct {
+	value any
+}
+
+// Sensitive, bir değeri loglarda maskelenecek şekilde işaretler.
+//
+// Örnek:
+//
+//	db.Table("users").Where("reset_code", "=", fluentsql.Sensitive(code)).First(&u)
+func Sensitive(v any) SensitiveValue {
+	if s, ok := v.(SensitiveValue); ok {
+		return s
+	}
+	return SensitiveValue{value: v}
+}
+
+// Unwrap, maskelenmiş ham değeri döndürür.
+func (s SensitiveValue) Unwrap() any {
+	return s.value
+}
+
+// Value, driver.Valuer implementasyonudur.
+func (s SensitiveValue) Value() (driver.Value, error) {
+	return driver.DefaultParameterConverter.ConvertValue(s.value)
+}
+
+// String, değerin yanlışlıkla fmt ile yazdırılmasına karşı maskeyi döndürür.
+func (s SensitiveValue) String() string {
+	return RedactedPlaceholder
+}
+
+// GoString, %#v biçimlendirmesinde de maskeyi döndürür.
+func (s SensitiveValue) GoString() string {
+	return RedactedPlaceholder
+}
+
+// ----------------------------------------------------------------------------
+// Hazır Logger'lar
+// ----------------------------------------------------------------------------
+
+// SlogLogger, sorguları log/slog üzerinden yapılandırılmış olarak loglar.
+// Başarılı sorgular Level seviyesinde, hatalı sorgular Error seviyesinde yazılır.
+type SlogLogger struct {
+	Logger *slog.Logger
+	Level  slog.Level
+}
+
+// NewSlogLogger, verilen slog.Logger ile Info seviyesinde loglayan bir Logger oluşturur.
+// l nil ise slog.Default() kullanılır.
+//
+// Örnek:
+//
+//	db := fluentsql.NewDB(sqlDB,
+//	    fluentsql.WithDebug(true),
+//	    fluentsql.WithLogger(fluentsql.NewSlogLogger(slog.Default())),
+//	)
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &SlogLogger{Logger: l, Level: slog.LevelInfo}
+}
+
+// Log, Logger implementasyonudur.
+func (l *SlogLogger) Log(query string, args []any, duration time.Duration, err error) {
+	attrs := []slog.Attr{
+		slog.String("sql", query),
+		slog.Any("args", args),
+		slog.Duration("duration", duration),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		l.Logger.LogAttrs(context.Background(), slog.LevelError, "fluentsql query failed", attrs...)
+		return
+	}
+	l.Logger.LogAttrs(context.Background(), l.Level, "fluentsql query", attrs...)
+}
+
+// StdLogger, sorguları standart kütüphanenin log.Logger'ı ile tek satır olarak yazar.
+//
+// Çıktı biçimi:
+//
+//	[fluentsql] 1.2ms SELECT * FROM `users` WHERE `id` = ? [42]
+type StdLogger struct {
+	Logger *log.Logger
+}
+
+// NewStdLogger, verilen log.Logger ile yazan bir Logger oluşturur.
+// l nil ise log.Default() kullanılır.
+func NewStdLogger(l *log.Logger) *StdLogger {
+	if l == nil {
+		l = log.Default()
+	}
+	return &StdLogger{Logger: l}
+}
+
+// Log, Logger implementasyonudur.
+func (l *StdLogger) Log(query string, args []any, duration time.Duration, err error) {
+	if err != nil {
+		l.Logger.Printf("[fluentsql] %s %s %v error=%v", duration, query, args, err)
+		return
+	}
+	l.Logger.Printf("[fluentsql] %s %s %v", duration, query, args)
+}
+
+// ----------------------------------------------------------------------------
+// Internal helpers
+// ----------------------------------------------------------------------------
+
+// isLogging, logger'ın gerçekten bir çıktı üretip üretmeyeceğini bildirir.
+func isLogging(logger Logger) bool {
+	if logger == nil {
+		return false
+	}
+	_, nop := logger.(NopLogger)
+	return !nop
+}
+
+// logQuery, debug modunda tüm sorguları, aksi halde yalnızca hataları loglar.
+// Sensitive değerler Logger'a ulaşmadan maskelenir.
+func logQuery(logger Logger, debug bool, query string, args []any, duration time.Duration, err error) {
+	if !isLogging(logger) || (!debug && err == nil) {
+		return
+	}
+	logger.Log(query, maskSensitive(args), duration, err)
+}
+
+// maskSensitive, Sensitive değerleri RedactedPlaceholder ile değiştirilmiş bir kopya döndürür.
+func maskSensitive(args []any) []any {
+	masked := make([]any, len(args))
+	for i, a := range args {
+		if _, ok := a.(SensitiveValue); ok {
+			masked[i] = RedactedPlaceholder
+			continue
+		}
+		masked[i] = a
+	}
+	return masked
+}
+
+// unwrapSensitive, sürücüye gidecek argümanlardan Sensitive sarmalayıcısını kaldırır.
+// Hiç Sensitive değer yoksa aynı slice döndürülür.
+func unwrapSensitive(args []any) []any {
+	var out []any
+	for i, a := range args {
+		s, ok := a.(SensitiveValue)
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = append([]any(nil), args...)
+		}
+		out[i] = s.value
+	}
+	if out == nil {
+		return args
+	}
+	return out
+}
+
+// isSensitiveColumn, kolon adının maskeleme kalıplarından birine uyup uymadığını bildirir.
+func isSensitiveColumn(column string, patterns []string) bool {
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		column = column[i+1:]
+	}
+	column = strings.ToLower(column)
+	for _, p := range patterns {
+		if p != "" && strings.Contains(column, strings.ToLower(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactWheres, hassas kolonlara ait koşul değerlerini Sensitive ile sarar.
+// İç içe koşullar özyineli olarak işlenir; orijinal slice değiştirilmez.
+//
+// WhereRaw/OrWhereRaw/HavingRaw bağlamaları bir kolona atfedilemediği için
+// kolon kalıplarıyla maskelenmez; bu değerler Sensitive(v) ile verilmelidir.
+func redactWheres(wheres []dialect.WhereClause, patterns []string) []dialect.WhereClause {
+	if len(wheres) == 0 {
+		return wheres
+	}
+
+	out := make([]dialect.WhereClause, len(wheres))
+	for i, w := range wheres {
+		if len(w.Nested) > 0 {
+			w.Nested = redactWheres(w.Nested, patterns)
+		}
+		if w.Column != "" && isSensitiveColumn(w.Column, patterns) {
+			if w.Value != nil {
+				w.Value = Sensitive(w.Value)
+			}
+			if len(w.Values) > 0 {
+				values := make([]any, len(w.Values))
+				for j, v := range w.Values {
+					values[j] = Sensitive(v)
+				}
+				w.Values = values
+			}
+		}
+		out[i] = w
+	}
+	return out
+}
+
+// redactData, INSERT/UPDATE haritasında hassas kolonların değerlerini Sensitive ile sarar.
+func redactData(data map[string]any, patterns []string) map[string]any {
+	var out map[string]any
+	for k, v := range data {
+		if !isSensitiveColumn(k, patterns) {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]any, len(data))
+			for kk, vv := range data {
+				out[kk] = vv
+			}
+		}
+		switch val := v.(type) {
+		case Expr:
+			val.Value = Sensitive(val.Value)
+			out[k] = val
+		case dialect.Expression:
+			// Ham SQL ifadeleri yer tutucu üretmez; bağlamaları kolona atfedilemez
+		default:
+			out[k] = Sensitive(v)
+		}
+	}
+	if out == nil {
+		return data
+	}
+	return out
+}
+
+// Compile-time kontrolü: SensitiveValue hem sürücüye hem fmt'ye güvenle verilebilir.
+var (
+	_ driver.Valuer  = SensitiveValue{}
+	_ fmt.Stringer   = SensitiveValue{}
+	_ fmt.GoStringer = SensitiveValue{}
+	_ Logger         = (*SlogLogger)(nil)
+	_ Logger         = (*StdLogger)(nil)
+)
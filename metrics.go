@@ -0,0 +1,102 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+// -----------------------------------------------------------------------------
+//  Metrikler — Sorgu Sayısı, Süre ve Hata Sınıfı
+//
+//  Çekirdek paket herhangi bir metrik kütüphanesine bağımlı değildir; yalnızca
+//  küçük bir MetricsRecorder arayüzü tanımlar. WithMetrics ile verilen
+//  kaydedici, interceptor zincirine eklenir ve her sorgunun işlemini,
+//  tablosunu, süresini ve hatasını alır. Prometheus entegrasyonu ayrı
+//  modüldedir: github.com/biyonik/go-fluent-sql/prometheus
+//
+//  Hatalar, düşük kardinaliteli etiketler üretmek için ErrorClass ile
+//  sınıflandırılır ("timeout", "connection", "validation" ...).
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// MetricsRecorder, sorgu metriklerini toplayan bileşenlerin arayüzüdür.
+// ObserveQuery her sorgudan sonra eşzamanlı olarak çağrılır; uygulamalar
+// thread-safe olmalı ve çağrıyı bloklamamalıdır.
+type MetricsRecorder interface {
+	ObserveQuery(operation, table string, duration time.Duration, err error)
+}
+
+// Hata sınıfları. ErrorClass bu değerlerden birini döndürür.
+const (
+	ErrorClassNone       = ""
+	ErrorClassNoRows     = "no_rows"
+	ErrorClassTimeout    = "timeout"
+	ErrorClassCanceled   = "canceled"
+	ErrorClassConnection = "connection"
+	ErrorClassTxClosed   = "tx_closed"
+	ErrorClassValidation = "validation"
+	ErrorClassCompile    = "compile"
+	ErrorClassConstraint = "constraint"
+	ErrorClassConflict   = "conflict"
+	ErrorClassDriver     = "driver"
+)
+
+// ErrorClass, hatayı metrik etiketi olarak kullanılabilecek sabit bir sınıfa
+// indirger. QueryError'lar sarmaladıkları hataya göre sınıflandırılır.
+// Constraint ihlalleri ErrorClassConstraint, deadlock / lock timeout /
+// serialization hataları ErrorClassConflict, diğer veritabanı hataları
+// ErrorClassDriver olarak raporlanır.
+func ErrorClass(err error) string {
+	var validationErr *ValidationError
+	var dialectErr *dialect.DialectError
+
+	switch {
+	case err == nil:
+		return ErrorClassNone
+	case errors.Is(err, ErrNoRows), errors.Is(err, sql.ErrNoRows):
+		return ErrorClassNoRows
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, ErrQueryTimeout):
+		return ErrorClassTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone), errors.Is(err, ErrConnectionClosed),
+		errors.Is(err, ErrConnectionLost):
+		return ErrorClassConnection
+	case errors.Is(err, ErrTxAlreadyClosed), errors.Is(err, sql.ErrTxDone):
+		return ErrorClassTxClosed
+	case errors.As(err, &validationErr):
+		return ErrorClassValidation
+	case errors.As(err, &dialectErr):
+		return ErrorClassCompile
+	case errors.Is(err, ErrUniqueViolation), errors.Is(err, ErrForeignKeyViolation),
+		errors.Is(err, ErrNotNullViolation), errors.Is(err, ErrCheckViolation):
+		return ErrorClassConstraint
+	case errors.Is(err, ErrDeadlock), errors.Is(err, ErrLockTimeout), errors.Is(err, ErrSerialization):
+		return ErrorClassConflict
+	}
+	return ErrorClassDriver
+}
+
+// metricsInterceptor, MetricsRecorder'ı interceptor zincirine bağlar.
+// Transaction yaşam döngüsü olayları (MethodTx) sorgu sayılmaz.
+func metricsInterceptor(m MetricsRecorder) Interceptor {
+	return func(ctx context.Context, q QueryInfo, next QueryHandler) (QueryOutcome, error) {
+		if q.Method == MethodTx {
+			return next(ctx, q)
+		}
+
+		start := time.Now()
+		out, err := next(ctx, q)
+		m.ObserveQuery(q.Operation, q.Table, time.Since(start), err)
+		return out, err
+	}
+}
@@ -0,0 +1,176 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+//  Advisory Lock — Eşzamanlı Deploy Koruması
+//
+//  Aynı anda birden fazla sunucu deploy edildiğinde hepsi migration
+//  çalıştırmaya kalkar. Migrator her işlemden önce veritabanı düzeyinde bir
+//  advisory lock alır; lock'u alamayan süreç bekler, süre dolarsa ErrLocked
+//  döner. Lock oturuma bağlı olduğundan havuzdan ayrılmış tek bir bağlantı
+//  üzerinde alınır ve aynı bağlantıda bırakılır.
+//
+//   • MySQL/MariaDB : GET_LOCK / RELEASE_LOCK (ad, veritabanı adıyla önekli)
+//   • PostgreSQL    : pg_try_advisory_lock / pg_advisory_unlock (64-bit anahtar)
+//   • SQLite        : Lock alınmaz; yazma işlemleri zaten veritabanı
+//                     düzeyinde tekildir.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// pgLockPoll, PostgreSQL lock'u tekrar denenmeden önce beklenen süredir.
+const pgLockPoll = 250 * time.Millisecond
+
+// lockKey, history tablosuna göre lock adını döndürür.
+func (m *Migrator) lockKey() string {
+	return "fluentsql_migrate:" + m.table
+}
+
+// lock, advisory lock'u alır ve lock'u bırakan fonksiyonu döndürür.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	switch m.grammar.Name() {
+	case "mysql":
+		return m.lockMySQL(ctx)
+	case "postgres":
+		return m.lockPostgres(ctx)
+	default:
+		return func() {}, nil
+	}
+}
+
+func (m *Migrator) lockMySQL(ctx context.Context) (func(), error) {
+	conn, err := m.db.DB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	const name = "CONCAT(IFNULL(DATABASE(), ''), ':', ?)"
+	timeout := int(math.Ceil(m.lockTimeout.Seconds()))
+
+	var got sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK("+name+", ?)", m.lockKey(), timeout).Scan(&got)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if got.Int64 != 1 {
+		conn.Close()
+		return nil, ErrLocked
+	}
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK("+name+")", m.lockKey())
+		conn.Close()
+	}, nil
+}
+
+func (m *Migrator) lockPostgres(ctx context.Context) (func(), error) {
+	conn, err := m.db.DB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(m.lockKey()))
+	key := int64(h.Sum64())
+
+	deadline := time.Now().Add(m.lockTimeout)
+	for {
+		var got bool
+		if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&got); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if got {
+			break
+		}
+		if time.Now().After(deadline) {
+			conn.Close()
+			return nil, ErrLocked
+		}
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return nil, ctx.Err()
+		case <-time.After(pgLockPoll):
+		}
+	}
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key)
+		conn.Close()
+	}, nil
+}
+
+// withLock, fn'i advisory lock altında çalıştırır.
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	if m.err != nil {
+		return m.err
+	}
+	release, err := m.lock(ctx)
+	if err != nil {
+		if errors.Is(err, ErrLocked) {
+			return err
+		}
+		return wrap("lock", err)
+	}
+	defer release()
+	return fn()
+}
+
+// ---------------------------------------------------------------------------
+// Dialect'e özgü history tablosu SQL'i
+// ---------------------------------------------------------------------------
+
+// quote, history tablosu adını grammar'ın tırnak karakteriyle sarar. Ad,
+// New sırasında doğrulanmıştır.
+func (m *Migrator) quote(name string) string {
+	q := `"`
+	if m.grammar.Name() == "mysql" {
+		q = "`"
+	}
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = q + p + q
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteName, katalogdan okunan bir tablo adını tırnaklar. Bu adlar
+// doğrulanmadığından tırnak karakteri ikilenerek kaçırılır.
+func (m *Migrator) quoteName(name string) string {
+	q := `"`
+	if m.grammar.Name() == "mysql" {
+		q = "`"
+	}
+	return q + strings.ReplaceAll(name, q, q+q) + q
+}
+
+// rebind, "?" yer tutucularını PostgreSQL için $1, $2 ... biçimine çevirir.
+func (m *Migrator) rebind(query string) string {
+	if m.grammar.Name() != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,459 @@
+// Package migrate, fluentsql için sürümlü migration çalıştırıcısıdır.
+//
+// Migration'lar Go fonksiyonları veya SQL dosyaları olarak kaydedilir ve
+// adlarına göre sırayla çalıştırılır. Uygulananlar, batch numarası ve
+// checksum ile birlikte bir history tablosunda ("migrations") tutulur:
+//
+//	m := migrate.New(db)
+//	err := m.Add(migrate.Migration{
+//	    Name: "20240115093000_create_users",
+//	    Up: func(ctx context.Context, s *migrate.Session) error {
+//	        return s.Schema().Create(ctx, "users", func(t *schema.Blueprint) {
+//	            t.ID()
+//	            t.String("email", 255).Unique()
+//	            t.Timestamps()
+//	        })
+//	    },
+//	    Down: func(ctx context.Context, s *migrate.Session) error {
+//	        return s.Schema().Drop(ctx, "users")
+//	    },
+//	})
+//
+//	ran, err := m.Up(ctx)
+//
+// Her işlem bir advisory lock altında çalışır; böylece aynı anda deploy
+// edilen süreçler migration'ları iki kez çalıştırmaz. DDL'in transaction
+// içinde geri alınabildiği veritabanlarında (PostgreSQL, SQLite) her
+// migration ve history kaydı tek bir Transaction'da çalışır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/internal/validation"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+// Sentinel errors for the migrate package.
+var (
+	// ErrLocked is returned when another process holds the migration lock past the lock timeout.
+	ErrLocked = errors.New("fluentsql: migrations are locked by another process")
+
+	// ErrChecksumMismatch is returned by Up when an applied migration's content has changed.
+	ErrChecksumMismatch = errors.New("fluentsql: applied migration has been modified")
+
+	// ErrMissingMigration is returned when rolling back a migration that is no longer registered.
+	ErrMissingMigration = errors.New("fluentsql: applied migration is not registered")
+
+	// ErrIrreversible is returned when rolling back a migration without a Down step.
+	ErrIrreversible = errors.New("fluentsql: migration has no down step")
+
+	// ErrDuplicateMigration is returned when two migrations share a name.
+	ErrDuplicateMigration = errors.New("fluentsql: duplicate migration name")
+)
+
+// DefaultTable, history tablosunun varsayılan adıdır. Diğer tablolar gibi
+// DB'nin tablo prefix'i uygulanır ("acme_migrations").
+const DefaultTable = "migrations"
+
+// DefaultLockTimeout, advisory lock için varsayılan bekleme süresidir.
+const DefaultLockTimeout = 30 * time.Second
+
+// Option, Migrator yapılandırma seçeneğidir.
+type Option func(*Migrator)
+
+// WithGrammar, şema grammar'ını belirler. Verilmezse DB'nin grammar adından
+// türetilir (bkz. schema.GrammarFor).
+func WithGrammar(g schema.Grammar) Option {
+	return func(m *Migrator) {
+		m.grammar = g
+	}
+}
+
+// WithTable, history tablosunun adını belirler. Ada DB'nin tablo prefix'i
+// uygulanır; böylece aynı veritabanını paylaşan kiracıların her biri kendi
+// history'sini tutar.
+func WithTable(name string) Option {
+	return func(m *Migrator) {
+		m.table = name
+	}
+}
+
+// WithLockTimeout, advisory lock için en fazla ne kadar bekleneceğini belirler.
+func WithLockTimeout(d time.Duration) Option {
+	return func(m *Migrator) {
+		m.lockTimeout = d
+	}
+}
+
+// Status, bir migration'ın durumudur.
+type Status struct {
+	Name     string
+	Applied  bool
+	Batch    int  // Uygulanmışsa batch numarası
+	Modified bool // Uygulandıktan sonra içeriği değişmiş
+	Missing  bool // Uygulanmış ama artık kayıtlı değil
+}
+
+// record, history tablosundaki bir satırdır.
+type record struct {
+	name     string
+	batch    int
+	checksum string
+}
+
+// Migrator, migration'ları kaydeder ve çalıştırır.
+type Migrator struct {
+	db          *fluentsql.DB
+	grammar     schema.Grammar
+	schema      *schema.Builder
+	table       string // Prefix uygulanmış history tablosu
+	lockTimeout time.Duration
+	migrations  map[string]Migration
+	err         error // Ertelenmiş yapılandırma hatası
+}
+
+// New, verilen DB için bir Migrator oluşturur. Yapılandırma hataları
+// (bilinmeyen grammar, geçersiz tablo adı) ilk işlemde döner.
+func New(db *fluentsql.DB, opts ...Option) *Migrator {
+	m := &Migrator{
+		db:          db,
+		table:       DefaultTable,
+		lockTimeout: DefaultLockTimeout,
+		migrations:  map[string]Migration{},
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.grammar == nil {
+		m.grammar, m.err = schema.GrammarFor(db.Grammar().Name())
+	}
+	if err := validation.ValidateIdentifier(m.table); err != nil && m.err == nil {
+		m.err = fluentsql.NewValidationError("identifier", m.table, err.Error())
+	}
+	m.table = fluentsql.PrefixTable(db.TablePrefix(), db.UnprefixedTables(), m.table)
+	m.schema = schema.NewBuilder(db, m.grammar).WithPrefix(db.TablePrefix()).WithUnprefixedTables(db.UnprefixedTables()...)
+	return m
+}
+
+// Add, migration'ları kaydeder.
+func (m *Migrator) Add(migrations ...Migration) error {
+	for _, mig := range migrations {
+		if mig.Name == "" || mig.Up == nil {
+			return fluentsql.NewValidationError("value", mig.Name, "migration requires a name and an up step")
+		}
+		if _, ok := m.migrations[mig.Name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateMigration, mig.Name)
+		}
+		m.migrations[mig.Name] = mig
+	}
+	return nil
+}
+
+// Migrations, kayıtlı migration'ları ad sırasıyla döndürür.
+func (m *Migrator) Migrations() []Migration {
+	out := make([]Migration, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		out = append(out, mig)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Up, bekleyen tüm migration'ları yeni bir batch olarak çalıştırır ve
+// çalıştırılanların adlarını döndürür. Bir migration başarısız olursa
+// sonrakiler çalıştırılmaz.
+func (m *Migrator) Up(ctx context.Context) ([]string, error) {
+	var ran []string
+	err := m.withLock(ctx, func() (err error) {
+		ran, err = m.up(ctx)
+		return err
+	})
+	return ran, err
+}
+
+// Down, son batch'i geri alır.
+func (m *Migrator) Down(ctx context.Context) ([]string, error) {
+	return m.rollback(ctx, func(history []record) []record {
+		if len(history) == 0 {
+			return nil
+		}
+		last := history[len(history)-1].batch
+		i := len(history)
+		for i > 0 && history[i-1].batch == last {
+			i--
+		}
+		return history[i:]
+	})
+}
+
+// Rollback, batch'lerden bağımsız olarak son steps migration'ı geri alır.
+func (m *Migrator) Rollback(ctx context.Context, steps int) ([]string, error) {
+	return m.rollback(ctx, func(history []record) []record {
+		if steps > len(history) {
+			steps = len(history)
+		}
+		if steps < 0 {
+			steps = 0
+		}
+		return history[len(history)-steps:]
+	})
+}
+
+// Fresh, Migrator'ın tablolarını siler ve migration'ları baştan çalıştırır.
+// DB'nin tablo prefix'i varsa yalnızca o prefix'le başlayan tablolar silinir;
+// prefix yoksa veritabanındaki TÜM tablolar silinir. Yalnızca geliştirme ve
+// test ortamları içindir.
+func (m *Migrator) Fresh(ctx context.Context) ([]string, error) {
+	var ran []string
+	err := m.withLock(ctx, func() (err error) {
+		if err := m.dropAllTables(ctx); err != nil {
+			return wrap("fresh", err)
+		}
+		ran, err = m.up(ctx)
+		return err
+	})
+	return ran, err
+}
+
+// Status, kayıtlı ve uygulanmış migration'ların durumunu ad sırasıyla döndürür.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	history, err := m.history(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[string]record{}
+	for _, r := range history {
+		applied[r.name] = r
+	}
+	var out []Status
+	for _, mig := range m.Migrations() {
+		s := Status{Name: mig.Name}
+		if r, ok := applied[mig.Name]; ok {
+			s.Applied, s.Batch = true, r.batch
+			s.Modified = modified(r, mig)
+			delete(applied, mig.Name)
+		}
+		out = append(out, s)
+	}
+	for _, r := range history {
+		if _, ok := applied[r.name]; ok {
+			out = append(out, Status{Name: r.name, Applied: true, Batch: r.batch, Missing: true})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// up, lock alınmış olarak bekleyen migration'ları çalıştırır.
+func (m *Migrator) up(ctx context.Context) ([]string, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	history, err := m.history(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[string]bool{}
+	batch := 0
+	for _, r := range history {
+		applied[r.name] = true
+		if r.batch > batch {
+			batch = r.batch
+		}
+		if mig, ok := m.migrations[r.name]; ok && modified(r, mig) {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, r.name)
+		}
+	}
+	batch++
+
+	var ran []string
+	for _, mig := range m.Migrations() {
+		if applied[mig.Name] {
+			continue
+		}
+		err := m.run(ctx, mig, mig.Up, func(ctx context.Context, exec fluentsql.QueryExecutor) error {
+			var checksum any
+			if mig.Checksum != "" {
+				checksum = mig.Checksum
+			}
+			_, err := exec.ExecContext(ctx, m.rebind("INSERT INTO "+m.quote(m.table)+" (migration, batch, checksum) VALUES (?, ?, ?)"),
+				mig.Name, batch, checksum)
+			return err
+		})
+		if err != nil {
+			return ran, err
+		}
+		ran = append(ran, mig.Name)
+	}
+	return ran, nil
+}
+
+// rollback, pick ile seçilen history kayıtlarını sondan başa geri alır.
+func (m *Migrator) rollback(ctx context.Context, pick func([]record) []record) ([]string, error) {
+	var ran []string
+	err := m.withLock(ctx, func() error {
+		if err := m.ensureTable(ctx); err != nil {
+			return err
+		}
+		history, err := m.history(ctx)
+		if err != nil {
+			return err
+		}
+		targets := pick(history)
+		for i := len(targets) - 1; i >= 0; i-- {
+			r := targets[i]
+			mig, ok := m.migrations[r.name]
+			if !ok {
+				return fmt.Errorf("%w: %s", ErrMissingMigration, r.name)
+			}
+			if mig.Down == nil {
+				return fmt.Errorf("%w: %s", ErrIrreversible, r.name)
+			}
+			err := m.run(ctx, mig, mig.Down, func(ctx context.Context, exec fluentsql.QueryExecutor) error {
+				_, err := exec.ExecContext(ctx, m.rebind("DELETE FROM "+m.quote(m.table)+" WHERE migration = ?"), r.name)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			ran = append(ran, r.name)
+		}
+		return nil
+	})
+	return ran, err
+}
+
+// run, migration adımını ve history güncellemesini çalıştırır. Grammar
+// transactional DDL destekliyorsa ikisi aynı transaction'dadır.
+func (m *Migrator) run(ctx context.Context, mig Migration, fn Func, record func(context.Context, fluentsql.QueryExecutor) error) error {
+	var err error
+	if m.grammar.SupportsTransactionalDDL() && !mig.NoTransaction {
+		err = m.db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+			s := &Session{db: m.db, tx: tx, schema: m.schema.Using(tx)}
+			if err := fn(ctx, s); err != nil {
+				return err
+			}
+			return record(ctx, tx)
+		})
+	} else {
+		err = fn(ctx, &Session{db: m.db, schema: m.schema})
+		if err == nil {
+			err = record(ctx, m.db)
+		}
+	}
+	return wrap(mig.Name, err)
+}
+
+// ensureTable, history tablosunu yoksa oluşturur.
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.schema.WithPrefix("").CreateIfNotExists(ctx, m.table, func(t *schema.Blueprint) {
+		t.Increments("id")
+		t.String("migration", 255)
+		t.Integer("batch")
+		t.String("checksum", 64).Nullable()
+		t.Timestamp("applied_at").UseCurrent()
+	})
+}
+
+// history, uygulanmış migration'ları uygulanma sırasıyla döndürür.
+func (m *Migrator) history(ctx context.Context) ([]record, error) {
+	rows, err := m.db.QueryContext(ctx, "SELECT migration, batch, checksum FROM "+m.quote(m.table)+" ORDER BY id")
+	if err != nil {
+		return nil, wrap("history", err)
+	}
+	defer rows.Close()
+
+	var out []record
+	for rows.Next() {
+		var r record
+		var checksum sql.NullString
+		if err := rows.Scan(&r.name, &r.batch, &checksum); err != nil {
+			return nil, wrap("history", err)
+		}
+		r.checksum = checksum.String
+		out = append(out, r)
+	}
+	return out, wrap("history", rows.Err())
+}
+
+// dropAllTables, geçerli veritabanındaki (PostgreSQL'de geçerli şemadaki)
+// Migrator'a ait tabloları foreign key kontrolleri kapalıyken siler. Tablo
+// prefix'i varsa diğer kiracıların ve paylaşımlı tabloların dokunulmaması
+// için yalnızca prefix'le başlayan tablolar silinir.
+func (m *Migrator) dropAllTables(ctx context.Context) error {
+	all, err := m.db.Schema().WithDialect(m.grammar.Name()).Tables(ctx)
+	if err != nil {
+		return err
+	}
+	prefix := m.db.TablePrefix()
+	var tables []string
+	for _, t := range all {
+		if strings.HasPrefix(t, prefix) {
+			tables = append(tables, t)
+		}
+	}
+
+	conn, err := m.db.DB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var before, after, suffix string
+	switch m.grammar.Name() {
+	case "mysql":
+		before, after = "SET FOREIGN_KEY_CHECKS = 0", "SET FOREIGN_KEY_CHECKS = 1"
+	case "postgres":
+		suffix = " CASCADE"
+	default:
+		before, after = "PRAGMA foreign_keys = OFF", "PRAGMA foreign_keys = ON"
+	}
+
+	if before != "" {
+		if _, err := conn.ExecContext(ctx, before); err != nil {
+			return err
+		}
+		defer func() { _, _ = conn.ExecContext(context.Background(), after) }()
+	}
+	for _, t := range tables {
+		if _, err := conn.ExecContext(ctx, "DROP TABLE IF EXISTS "+m.quoteName(t)+suffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modified, uygulanmış kaydın checksum'ının kayıtlı migration'dan farklı olup
+// olmadığını bildirir. Checksum'ı olmayan migration'lar karşılaştırılmaz.
+func modified(r record, mig Migration) bool {
+	return r.checksum != "" && mig.Checksum != "" && r.checksum != mig.Checksum
+}
+
+// wrap, hatayı migrate bağlamıyla sarar.
+func wrap(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fluentsql.WrapError("migrate "+op, err)
+}
@@ -0,0 +1,254 @@
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+// -----------------------------------------------------------------------------
+//  Migration Tanımları — Go Fonksiyonları ve SQL Dosyaları
+//
+//  Bir migration, adıyla sıralanan bir Up/Down çiftidir. Adlar genellikle
+//  zaman damgası ile başlar ("20240115093000_create_users") ve çalıştırma
+//  sırası ad sırasıdır.
+//
+//  Go migration'ları Session üzerinden şema builder'ına ve sorgu builder'ına
+//  erişir; SQL migration'ları ise "<ad>.up.sql" / "<ad>.down.sql" dosyalarından
+//  okunur ve ifade ifade çalıştırılır. SQL migration'larının checksum'ı
+//  history tablosunda saklanır; uygulanmış bir dosya sonradan değiştirilirse
+//  Up ErrChecksumMismatch ile durur.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Func, bir migration adımıdır.
+type Func func(ctx context.Context, s *Session) error
+
+// Migration, tek bir migration tanımıdır.
+type Migration struct {
+	Name string
+	Up   Func
+	Down Func // nil ise migration geri alınamaz
+
+	// Checksum, migration içeriğinin özetidir. SQL migration'ları için
+	// otomatik hesaplanır; boşsa değişiklik kontrolü yapılmaz.
+	Checksum string
+
+	// NoTransaction, transactional DDL destekleyen veritabanlarında bile
+	// migration'ı transaction dışında çalıştırır (ör. PostgreSQL'de
+	// CREATE INDEX CONCURRENTLY).
+	NoTransaction bool
+}
+
+// Session, bir migration adımının çalıştığı bağlamdır. Migration
+// transaction içinde çalışıyorsa tüm işlemler o transaction'a bağlıdır.
+type Session struct {
+	db     *fluentsql.DB
+	tx     *fluentsql.Transaction
+	schema *schema.Builder
+}
+
+// Schema, DDL için şema builder'ını döndürür.
+func (s *Session) Schema() *schema.Builder {
+	return s.schema
+}
+
+// Table, veri migration'ları için sorgu builder'ı döndürür.
+func (s *Session) Table(name string) *fluentsql.Builder {
+	if s.tx != nil {
+		return s.tx.Table(name)
+	}
+	return s.db.Table(name)
+}
+
+// Exec, ham bir SQL ifadesi çalıştırır.
+func (s *Session) Exec(ctx context.Context, query string, args ...any) error {
+	_, err := s.executor().ExecContext(ctx, query, args...)
+	return err
+}
+
+// Tx, migration'ın çalıştığı transaction'ı döndürür; transaction yoksa nil.
+func (s *Session) Tx() *fluentsql.Transaction {
+	return s.tx
+}
+
+func (s *Session) executor() fluentsql.QueryExecutor {
+	if s.tx != nil {
+		return s.tx
+	}
+	return s.db
+}
+
+// SQL, SQL metinlerinden bir migration oluşturur. Metinler ";" ile ayrılmış
+// birden fazla ifade içerebilir; down boşsa migration geri alınamaz.
+func SQL(name, up, down string) Migration {
+	m := Migration{Name: name, Up: sqlFunc(up), Checksum: Checksum(up)}
+	if strings.TrimSpace(down) != "" {
+		m.Down = sqlFunc(down)
+	}
+	return m
+}
+
+// Checksum, bir SQL migration metninin SHA-256 özetini döndürür.
+func Checksum(sql string) string {
+	sum := sha256.Sum256([]byte(sql))
+	return hex.EncodeToString(sum[:])
+}
+
+// sqlFunc, SQL metnini ifadelerine ayırıp sırayla çalıştıran Func döndürür.
+func sqlFunc(sql string) Func {
+	stmts := SplitStatements(sql)
+	return func(ctx context.Context, s *Session) error {
+		for _, stmt := range stmts {
+			if err := s.Exec(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// FromFS, dizindeki "<ad>.up.sql" ve "<ad>.down.sql" dosyalarından
+// migration'ları okur. Karşılığı olmayan bir down dosyası hatadır.
+//
+// Örnek:
+//
+//	//go:embed migrations/*.sql
+//	var files embed.FS
+//
+//	migrations, err := migrate.FromFS(files, "migrations")
+func FromFS(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ups := map[string]string{}
+	downs := map[string]string{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		var name string
+		var target map[string]string
+		switch file := e.Name(); {
+		case strings.HasSuffix(file, ".up.sql"):
+			name, target = strings.TrimSuffix(file, ".up.sql"), ups
+		case strings.HasSuffix(file, ".down.sql"):
+			name, target = strings.TrimSuffix(file, ".down.sql"), downs
+		default:
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		target[name] = string(data)
+	}
+
+	for name := range downs {
+		if _, ok := ups[name]; !ok {
+			return nil, fmt.Errorf("fluentsql: migration %q has a down file but no up file", name)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(ups))
+	for name, up := range ups {
+		migrations = append(migrations, SQL(name, up, downs[name]))
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Name < migrations[j].Name })
+	return migrations, nil
+}
+
+// SplitStatements, SQL metnini ";" ile biten ifadelere ayırır. Tırnak
+// içindeki (', ", `), yorumlardaki (--, /* */) ve PostgreSQL dollar-quote
+// ($$ ... $$) bloklarındaki ";" karakterleri ayırıcı sayılmaz. Yalnızca
+// yorumdan oluşan parçalar atlanır.
+func SplitStatements(sql string) []string {
+	var (
+		stmts   []string
+		start   int
+		content bool // Parçada yorum dışı içerik var mı
+	)
+	flush := func(end int) {
+		if stmt := strings.TrimSpace(sql[start:end]); stmt != "" && content {
+			stmts = append(stmts, stmt)
+		}
+		content = false
+	}
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(sql)
+			}
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(sql)
+			}
+		case c == '\'' || c == '"' || c == '`':
+			content = true
+			for i++; i < len(sql); i++ {
+				if sql[i] == '\\' && c == '\'' {
+					i++
+					continue
+				}
+				if sql[i] == c {
+					break
+				}
+			}
+		case c == '$':
+			content = true
+			if tag := dollarTag(sql[i:]); tag != "" {
+				if end := strings.Index(sql[i+len(tag):], tag); end >= 0 {
+					i += len(tag) + end + len(tag) - 1
+				} else {
+					i = len(sql)
+				}
+			}
+		case c == ';':
+			flush(i)
+			start = i + 1
+		case c != ' ' && c != '\t' && c != '\n' && c != '\r':
+			content = true
+		}
+	}
+	if start < len(sql) {
+		flush(len(sql))
+	}
+	return stmts
+}
+
+// dollarTag, s bir PostgreSQL dollar-quote etiketiyle ($$ veya $tag$)
+// başlıyorsa etiketi döndürür.
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '$':
+			return s[:i+1]
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 1 && c >= '0' && c <= '9'):
+		default:
+			return ""
+		}
+	}
+	return ""
+}
@@ -1,6 +1,10 @@
 package fluentsql
 
-import "github.com/biyonik/go-fluent-sql/dialect"
+import (
+	"time"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
 
 // -----------------------------------------------------------------------------
 //  Bu dosya; FluentSQL yapısının çekirdek konfigürasyon katmanını oluşturan,
@@ -48,7 +52,7 @@ type Option func(*DB)
 //
 // Örnek:
 //
-//	db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(fluentsql.NewPostgreSQLGrammar()))
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(dialect.Postgres()))
 func WithGrammar(g dialect.Grammar) Option {
 	return func(d *DB) {
 		d.grammar = g
@@ -109,6 +113,28 @@ func WithLogger(logger Logger) Option {
 	}
 }
 
+// WithRedactedColumns fonksiyonu, loglarda değeri maskelenecek kolon kalıplarını
+// belirler. Varsayılan liste (DefaultRedactedColumns) tamamen değiştirilir;
+// genişletmek için varsayılanlar da verilmelidir. Boş çağrı kolon bazlı
+// maskelemeyi kapatır, Sensitive() ile işaretlenen değerler yine maskelenir.
+//
+// Kalıplar yalnızca kolonu bilinen değerlere uygulanır (Where, WhereIn,
+// Insert/Update haritaları). WhereRaw, HavingRaw gibi ham ifadelerin ve Raw
+// değerlerin bağlamaları maskelenmez; bunlar Sensitive(v) ile sarılmalıdır:
+//
+//	db.Table("users").WhereRaw("LOWER(email) = ?", fluentsql.Sensitive(email))
+//
+// Örnek:
+//
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithRedactedColumns(
+//	    append(fluentsql.DefaultRedactedColumns, "iban", "ssn")...,
+//	))
+func WithRedactedColumns(columns ...string) Option {
+	return func(d *DB) {
+		d.redact = append([]string(nil), columns...)
+	}
+}
+
 // WithTablePrefix fonksiyonu tüm tablo adlarına otomatik olarak prefix ekler.
 // Çok tenantlı sistemlerde her müşterinin verisini ayırmak ya da proje genelinde
 // ad çakışmalarını önlemek için oldukça zarif bir yaklaşımdır.
@@ -123,6 +149,125 @@ func WithTablePrefix(prefix string) Option {
 	}
 }
 
+// WithUnprefixedTables fonksiyonu, tablo önekinin uygulanmayacağı paylaşımlı
+// tabloları belirler. Kiracıya özel tabloların yanında tek kopya tutulan
+// tablolar (örn. migrations, tenants) için kullanılır. Bu tablolarla
+// nitelenen kolonlar ("tenants.id") da öneklenmez.
+//
+// Örnek:
+//
+//	db := fluentsql.NewDB(sqlDB,
+//	    fluentsql.WithTablePrefix("acme_"),
+//	    fluentsql.WithUnprefixedTables("tenants", "migrations"),
+//	)
+//	// db.Table("users").Join("tenants", "tenants.id", "=", "users.tenant_id")
+//	// → FROM `acme_users` INNER JOIN `tenants` ON `tenants`.`id` = `acme_users`.`tenant_id`
+func WithUnprefixedTables(tables ...string) Option {
+	return func(d *DB) {
+		d.unprefixed = append(d.unprefixed, tables...)
+	}
+}
+
+// WithInterceptor fonksiyonu, Builder ve Transaction üzerinden çalışan her
+// sorguyu saran bir interceptor ekler. Birden fazla çağrıldığında ilk eklenen
+// en dışta çalışır. Tracing, metrik, audit veya önbellekleme gibi kesişen
+// ihtiyaçlar kütüphaneyi değiştirmeden bu kanca ile eklenebilir.
+//
+// Örnek:
+//
+//	audit := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+//	    if q.Operation == "delete" {
+//	        log.Printf("delete on %s: %s %v", q.Table, q.SQL, q.RedactedArgs())
+//	    }
+//	    return next(ctx, q)
+//	}
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(audit))
+func WithInterceptor(ic Interceptor) Option {
+	return func(d *DB) {
+		if ic != nil {
+			d.interceptors = append(d.interceptors, ic)
+		}
+	}
+}
+
+// WithMetrics fonksiyonu, her sorgunun işlem, tablo, süre ve hata bilgisini
+// verilen MetricsRecorder'a raporlar. Kaydedici interceptor zincirine,
+// çağrıldığı sırada eklenir.
+//
+// Örnek:
+//
+//	collector := fsprom.NewCollector(sqlDB)
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithMetrics(collector))
+//	prometheus.MustRegister(collector)
+func WithMetrics(m MetricsRecorder) Option {
+	return func(d *DB) {
+		if m != nil {
+			d.interceptors = append(d.interceptors, metricsInterceptor(m))
+		}
+	}
+}
+
+// WithSlowQueryThreshold fonksiyonu, süresi threshold'u aşan her sorguyu
+// handler'a bildirir. Eşik sıfır veya negatifse ya da handler nil ise
+// seçenek etkisizdir. Plan yakalamak için WithSlowQueryExplain ile birlikte
+// kullanılır.
+//
+// Örnek:
+//
+//	db := fluentsql.NewDB(sqlDB,
+//	    fluentsql.WithSlowQueryThreshold(200*time.Millisecond, func(ctx context.Context, q fluentsql.SlowQuery) {
+//	        slog.Warn("slow query", "sql", q.SQL, "duration", q.Duration, "full_scans", q.Plan.FullScans())
+//	    }),
+//	    fluentsql.WithSlowQueryExplain(true),
+//	)
+func WithSlowQueryThreshold(threshold time.Duration, handler SlowQueryHandler) Option {
+	return func(d *DB) {
+		if threshold > 0 && handler != nil {
+			d.interceptors = append(d.interceptors, slowQueryInterceptor(d, threshold, handler))
+		}
+	}
+}
+
+// WithSlowQueryExplain fonksiyonu, yavaş sorgular için aynı SQL ve
+// bağlamalarla EXPLAIN çalıştırılıp planın SlowQuery.Plan alanına
+// eklenmesini sağlar. EXPLAIN ek bir sorgudur; yalnızca eşiği aşan
+// sorgular için çalıştırılır.
+func WithSlowQueryExplain(enabled bool) Option {
+	return func(d *DB) {
+		d.explainSlow = enabled
+	}
+}
+
+// WithCluster fonksiyonu, okumaları replica'lara, yazmaları primary'ye
+// yönlendiren bir Cluster bağlar. DB'nin kendi bağlantısı cluster'ın
+// primary'si ile değiştirilir; transaction'lar her zaman primary'de açılır.
+//
+// Örnek:
+//
+//	cluster := fluentsql.NewCluster(primary, replica1, replica2)
+//	db := fluentsql.NewDB(primary, fluentsql.WithCluster(cluster))
+func WithCluster(c *Cluster) Option {
+	return func(d *DB) {
+		if c != nil {
+			d.cluster = c
+			d.DB = c.Primary()
+		}
+	}
+}
+
+// WithRetryPolicy fonksiyonu, DB.Transaction ve transaction dışındaki Builder
+// yazmaları için varsayılan yeniden deneme politikasını belirler. Varsayılan
+// olarak yeniden deneme yapılmaz.
+//
+// Örnek:
+//
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithRetryPolicy(fluentsql.DefaultRetryPolicy()))
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(d *DB) {
+		d.retry = p
+	}
+}
+
 // BuilderOption tipi, yalnızca query bazlı kullanılan yapılandırmalardır.
 // DB Option'larından farklıdır çünkü her sorguda ayrı davranışlara izin verir.
 //
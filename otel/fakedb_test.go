@@ -0,0 +1,119 @@
+package otel_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeDB, span testleri için gerçek veritabanı gerektirmeyen sahte sürücüdür.
+// fail içinde geçen SQL'ler için fail'deki hata döndürülür.
+type fakeDB struct {
+	mu   sync.Mutex
+	fail map[string]error
+}
+
+var (
+	fakeRegisterOnce sync.Once
+	fakeInstances    sync.Map // dsn → *fakeDB
+	fakeCounter      atomic.Int64
+)
+
+func newFakeDB(t *testing.T) (*sql.DB, *fakeDB) {
+	t.Helper()
+
+	fakeRegisterOnce.Do(func() {
+		sql.Register("fluentsql-otel-fake", fakeDriver{})
+	})
+
+	fake := &fakeDB{fail: make(map[string]error)}
+	dsn := fmt.Sprintf("fake-%d", fakeCounter.Add(1))
+	fakeInstances.Store(dsn, fake)
+
+	db, err := sql.Open("fluentsql-otel-fake", dsn)
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		fakeInstances.Delete(dsn)
+	})
+	return db, fake
+}
+
+// Fail, verilen SQL çalıştırıldığında err döndürülmesini sağlar.
+func (f *fakeDB) Fail(query string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fail[query] = err
+}
+
+func (f *fakeDB) err(query string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.fail[query]
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	v, ok := fakeInstances.Load(dsn)
+	if !ok {
+		return nil, errors.New("fakedb: unknown dsn " + dsn)
+	}
+	return &fakeConn{db: v.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("fakedb: prepare not supported")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if err := c.db.err("BEGIN"); err != nil {
+		return nil, err
+	}
+	return &fakeTx{db: c.db}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := c.db.err(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if err := c.db.err(query); err != nil {
+		return nil, err
+	}
+	return fakeRows{}, nil
+}
+
+type fakeTx struct {
+	db *fakeDB
+}
+
+func (tx *fakeTx) Commit() error   { return tx.db.err("COMMIT") }
+func (tx *fakeTx) Rollback() error { return tx.db.err("ROLLBACK") }
+
+type fakeRows struct{}
+
+func (fakeRows) Columns() []string         { return []string{"id"} }
+func (fakeRows) Close() error              { return nil }
+func (fakeRows) Next([]driver.Value) error { return io.EOF }
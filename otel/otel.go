@@ -0,0 +1,277 @@
+// Package otel, fluentsql için OpenTelemetry tracing entegrasyonudur.
+//
+// Ayrı bir Go modülü olarak sunulur; böylece çekirdek paket OpenTelemetry
+// bağımlılığı taşımaz. Entegrasyon, fluentsql'in interceptor zincirine
+// takılan tek bir Interceptor'dan ibarettir:
+//
+//	import fsotel "github.com/biyonik/go-fluent-sql/otel"
+//
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(fsotel.Interceptor()))
+//
+// Her sorgu için, *Context metoduna verilen context'in span'ine bağlı bir
+// client span'i açılır ve veritabanı semantik kuralları (db.system,
+// db.operation, db.sql.table, db.statement) ile etiketlenir. Transaction'lar
+// begin'den commit/rollback'e kadar süren bir üst span alır; transaction
+// içindeki sorgular bu span'in altında yer alır, savepoint'ler ise üst span'e
+// olay (event) olarak eklenir.
+package otel
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// -----------------------------------------------------------------------------
+//  OpenTelemetry Tracing — Sorgudan Span'e
+//
+//  Span hiyerarşisi:
+//
+//    caller span
+//     └─ transaction              (BeginTx → Commit/Rollback)
+//         ├─ UPDATE users         (transaction içindeki sorgular)
+//         │   event: savepoint    (SAVEPOINT/ROLLBACK TO/RELEASE)
+//         └─ SELECT orders
+//
+//  Notlar:
+//   • db.statement placeholder'lı SQL'dir; Raw ifadelerdeki string ve sayı
+//     literal'leri (MySQL/MariaDB'de çift tırnaklılar dahil) "?" ile değiştirilir.
+//     Bağlamalar hiçbir zaman span'e yazılmaz.
+//   • QueryContext span'i satırlar okunmadan, sürücü çağrısı dönünce kapanır.
+//   • Commit veya Rollback edilmeyen bir transaction'ın span'i kapanmaz.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ScopeName, span'leri üreten tracer'ın instrumentation scope adıdır.
+const ScopeName = "github.com/biyonik/go-fluent-sql/otel"
+
+// SavepointKey, savepoint olaylarında savepoint adını taşıyan attribute'tur.
+const SavepointKey = attribute.Key("db.fluentsql.savepoint")
+
+// TransactionOutcomeKey, transaction span'inin nasıl kapandığını ("commit",
+// "rollback") bildiren attribute'tur.
+const TransactionOutcomeKey = attribute.Key("db.fluentsql.transaction.outcome")
+
+// Option, Interceptor yapılandırmasını değiştirir.
+type Option func(*config)
+
+type config struct {
+	provider   trace.TracerProvider
+	statement  bool
+	attributes []attribute.KeyValue
+}
+
+// WithTracerProvider, span'lerin üretileceği TracerProvider'ı belirler.
+// Verilmezse global provider (otel.GetTracerProvider) kullanılır.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = tp
+	}
+}
+
+// WithStatement, db.statement attribute'unun yazılıp yazılmayacağını belirler.
+// Varsayılan olarak açıktır.
+func WithStatement(enabled bool) Option {
+	return func(c *config) {
+		c.statement = enabled
+	}
+}
+
+// WithAttributes, her span'e eklenecek sabit attribute'ları tanımlar
+// (ör. db.name veya server.address).
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return func(c *config) {
+		c.attributes = append(c.attributes, attrs...)
+	}
+}
+
+// txSpan, açık bir transaction'ın span'ini ve başlatıldığı anki üst span'i tutar.
+type txSpan struct {
+	span   trace.Span
+	parent trace.SpanContext
+}
+
+// tracer, Interceptor'ın durumunu taşır.
+type tracer struct {
+	cfg    config
+	tracer trace.Tracer
+	txs    sync.Map // *fluentsql.Transaction → *txSpan
+}
+
+// Interceptor, her sorgu ve transaction için span üreten bir fluentsql.Interceptor döndürür.
+func Interceptor(opts ...Option) fluentsql.Interceptor {
+	cfg := config{statement: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.provider == nil {
+		cfg.provider = otel.GetTracerProvider()
+	}
+
+	t := &tracer{
+		cfg:    cfg,
+		tracer: cfg.provider.Tracer(ScopeName, trace.WithSchemaURL(semconv.SchemaURL)),
+	}
+	return t.intercept
+}
+
+func (t *tracer) intercept(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+	switch {
+	case q.Method == fluentsql.MethodTx && q.Operation == "begin":
+		return t.begin(ctx, q, next)
+	case q.Method == fluentsql.MethodTx:
+		return t.finish(ctx, q, next)
+	case strings.HasSuffix(q.Operation, "savepoint"):
+		return t.savepoint(ctx, q, next)
+	}
+	return t.query(ctx, q, next)
+}
+
+// begin, transaction span'ini açar ve Transaction.Context()'e yerleştirir.
+func (t *tracer) begin(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+	parent := trace.SpanContextFromContext(ctx)
+	ctx, span := t.tracer.Start(ctx, "transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(t.baseAttributes(q)...),
+	)
+
+	out, err := next(ctx, q)
+	if err != nil {
+		recordError(span, err)
+		span.End()
+		return out, err
+	}
+	if q.Tx != nil {
+		t.txs.Store(q.Tx, &txSpan{span: span, parent: parent})
+	}
+	return out, nil
+}
+
+// finish, commit veya rollback sonrasında transaction span'ini kapatır.
+func (t *tracer) finish(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+	out, err := next(ctx, q)
+
+	v, ok := t.txs.LoadAndDelete(q.Tx)
+	if !ok {
+		return out, err
+	}
+	span := v.(*txSpan).span
+	span.SetAttributes(TransactionOutcomeKey.String(q.Operation))
+	if err != nil {
+		recordError(span, err)
+	}
+	span.End()
+	return out, err
+}
+
+// savepoint, savepoint ifadelerini ayrı span yerine transaction span'ine olay olarak ekler.
+func (t *tracer) savepoint(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+	out, err := next(ctx, q)
+
+	if tx := t.transaction(q); tx != nil {
+		attrs := []attribute.KeyValue{}
+		if fields := strings.Fields(q.SQL); len(fields) > 0 {
+			// Gramerin eklediği tırnaklar (`sp`, "sp", [sp]) atılır
+			attrs = append(attrs, SavepointKey.String(strings.Trim(fields[len(fields)-1], "`\"[]")))
+		}
+		if err != nil {
+			attrs = append(attrs, attribute.String("error", err.Error()))
+		}
+		tx.span.AddEvent(q.Operation, trace.WithAttributes(attrs...))
+	}
+	return out, err
+}
+
+// query, tek bir sorgu için client span'i açar.
+func (t *tracer) query(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+	operation := operationName(q)
+
+	attrs := append(t.baseAttributes(q), semconv.DBOperation(operation))
+	if q.Table != "" {
+		attrs = append(attrs, semconv.DBSQLTable(q.Table))
+	}
+	if t.cfg.statement && q.SQL != "" {
+		attrs = append(attrs, semconv.DBStatement(SanitizeStatement(q.Dialect, q.SQL)))
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	}
+
+	// Transaction içindeki sorgular transaction span'inin altına yerleşir;
+	// çağıranın farklı bir span'i varsa ona link verilir.
+	spanCtx := ctx
+	if tx := t.transaction(q); tx != nil {
+		caller := trace.SpanContextFromContext(ctx)
+		if caller.IsValid() && !caller.Equal(tx.parent) && !caller.Equal(tx.span.SpanContext()) {
+			opts = append(opts, trace.WithLinks(trace.Link{SpanContext: caller}))
+		}
+		spanCtx = trace.ContextWithSpan(ctx, tx.span)
+	}
+
+	name := operation
+	if q.Table != "" {
+		name += " " + q.Table
+	}
+	spanCtx, span := t.tracer.Start(spanCtx, name, opts...)
+	defer span.End()
+
+	out, err := next(spanCtx, q)
+	if err != nil {
+		recordError(span, err)
+	}
+	return out, err
+}
+
+// transaction, sorgunun ait olduğu transaction'ın açık span'ini döndürür.
+func (t *tracer) transaction(q fluentsql.QueryInfo) *txSpan {
+	if q.Tx == nil {
+		return nil
+	}
+	if v, ok := t.txs.Load(q.Tx); ok {
+		return v.(*txSpan)
+	}
+	return nil
+}
+
+// baseAttributes, her span'de bulunan attribute'ları döndürür.
+func (t *tracer) baseAttributes(q fluentsql.QueryInfo) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(t.cfg.attributes)+4)
+	if q.Dialect != "" {
+		attrs = append(attrs, semconv.DBSystemKey.String(q.Dialect))
+	}
+	return append(attrs, t.cfg.attributes...)
+}
+
+// operationName, db.operation değerini üretir. Builder işlemleri SQL
+// komutuna eşlenir; ham sorgularda SQL'in ilk anahtar kelimesi kullanılır.
+func operationName(q fluentsql.QueryInfo) string {
+	switch q.Operation {
+	case "select", "count", "exists":
+		return "SELECT"
+	case "insert", "update", "delete":
+		return strings.ToUpper(q.Operation)
+	}
+	if keyword := firstKeyword(q.SQL); keyword != "" {
+		return keyword
+	}
+	return strings.ToUpper(q.Operation)
+}
+
+// recordError, hatayı span'e kaydeder ve durumunu Error yapar.
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
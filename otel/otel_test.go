@@ -0,0 +1,171 @@
+package otel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	fsotel "github.com/biyonik/go-fluent-sql/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newTracedDB, in-memory exporter'a span yazan bir DB döndürür.
+func newTracedDB(t *testing.T) (*fluentsql.DB, *fakeDB, *tracetest.InMemoryExporter, *sdktrace.TracerProvider) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(fsotel.Interceptor(fsotel.WithTracerProvider(tp))))
+	return db, fake, exporter, tp
+}
+
+func attr(span tracetest.SpanStub, key attribute.Key) string {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func findSpan(t *testing.T, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+	for _, s := range spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	t.Fatalf("span %q not found in %d spans", name, len(spans))
+	return tracetest.SpanStub{}
+}
+
+func TestInterceptor_QuerySpan(t *testing.T) {
+	db, fake, exporter, tp := newTracedDB(t)
+
+	ctx, root := tp.Tracer("test").Start(context.Background(), "request")
+	if _, err := db.Table("users").Where("id", "=", 7).UpdateContext(ctx, map[string]any{"name": "x"}); err != nil {
+		t.Fatalf("UpdateContext() error = %v", err)
+	}
+
+	boom := errors.New("table is locked")
+	fake.Fail("DELETE FROM `users` WHERE `id` = ?", boom)
+	if _, err := db.Table("users").Where("id", "=", 7).DeleteContext(ctx); !errors.Is(err, boom) {
+		t.Fatalf("DeleteContext() error = %v, want %v", err, boom)
+	}
+	root.End()
+
+	spans := exporter.GetSpans()
+	update := findSpan(t, spans, "UPDATE users")
+	if update.Parent.SpanID() != root.SpanContext().SpanID() {
+		t.Errorf("query span parent = %v, want caller span", update.Parent.SpanID())
+	}
+	want := map[attribute.Key]string{
+		"db.system":    "mysql",
+		"db.operation": "UPDATE",
+		"db.sql.table": "users",
+		"db.statement": "UPDATE `users` SET `name` = ? WHERE `id` = ?",
+	}
+	for key, value := range want {
+		if got := attr(update, key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+
+	del := findSpan(t, spans, "DELETE users")
+	if del.Status.Code != codes.Error || len(del.Events) == 0 {
+		t.Errorf("failed query span status = %v, events = %d; want recorded error", del.Status, len(del.Events))
+	}
+}
+
+func TestInterceptor_TransactionSpan(t *testing.T) {
+	db, _, exporter, tp := newTracedDB(t)
+
+	ctx, root := tp.Tracer("test").Start(context.Background(), "request")
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		if err := tx.Savepoint("before_items"); err != nil {
+			return err
+		}
+		if _, err := tx.Table("orders").Where("id", "=", 1).UpdateContext(ctx, map[string]any{"total": 10}); err != nil {
+			return err
+		}
+		return tx.RollbackTo("before_items")
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+	root.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("exported %d spans, want request, transaction and query", len(spans))
+	}
+
+	txSpan := findSpan(t, spans, "transaction")
+	if txSpan.Parent.SpanID() != root.SpanContext().SpanID() {
+		t.Errorf("transaction span parent = %v, want caller span", txSpan.Parent.SpanID())
+	}
+	if got := attr(txSpan, fsotel.TransactionOutcomeKey); got != "commit" {
+		t.Errorf("transaction outcome = %q, want commit", got)
+	}
+
+	var events []string
+	for _, e := range txSpan.Events {
+		for _, kv := range e.Attributes {
+			if kv.Key == fsotel.SavepointKey {
+				events = append(events, e.Name+":"+kv.Value.AsString())
+			}
+		}
+	}
+	if len(events) != 2 || events[0] != "savepoint:before_items" || events[1] != "rollback_to_savepoint:before_items" {
+		t.Errorf("savepoint events = %v", events)
+	}
+
+	update := findSpan(t, spans, "UPDATE orders")
+	if update.Parent.SpanID() != txSpan.SpanContext.SpanID() {
+		t.Errorf("query span parent = %v, want transaction span", update.Parent.SpanID())
+	}
+	if len(update.Links) != 0 {
+		t.Errorf("query span links = %v, want none for the transaction's own caller", update.Links)
+	}
+}
+
+func TestInterceptor_StatementHidesDoubleQuotedLiterals(t *testing.T) {
+	db, _, exporter, _ := newTracedDB(t)
+
+	if _, err := db.Table("users").WhereRaw(`token = "secret"`).DeleteContext(context.Background()); err != nil {
+		t.Fatalf("DeleteContext() error = %v", err)
+	}
+
+	span := findSpan(t, exporter.GetSpans(), "DELETE users")
+	if got, want := attr(span, "db.statement"), "DELETE FROM `users` WHERE token = ?"; got != want {
+		t.Errorf("db.statement = %q, want %q", got, want)
+	}
+}
+
+func TestSanitizeStatement(t *testing.T) {
+	tests := []struct {
+		dialect, in, want string
+	}{
+		{"mysql", "SELECT * FROM `users` WHERE `id` = ?", "SELECT * FROM `users` WHERE `id` = ?"},
+		{"mysql", "UPDATE t1 SET name = 'O''Brien', note = 'a\\'b' WHERE id = 42", "UPDATE t1 SET name = ?, note = ? WHERE id = ?"},
+		{"postgres", `SELECT "users"."email" FROM "users" WHERE "users"."id" = 7 AND "a""b2" = 'x'`, `SELECT "users"."email" FROM "users" WHERE "users"."id" = ? AND "a""b2" = ?`},
+		{"mysql", "SELECT `col_2`, price * 1.5e3 FROM `t` WHERE flag = 0xFF", "SELECT `col_2`, price * ? FROM `t` WHERE flag = ?"},
+		{"mysql", "SELECT `it's` FROM x LIMIT 10, 20", "SELECT `it's` FROM x LIMIT ?, ?"},
+		{"mysql", `SELECT * FROM users WHERE token = "secret" AND note = "say ""hi"""`, `SELECT * FROM users WHERE token = ? AND note = ?`},
+		{"mariadb", `SELECT * FROM users WHERE token = "secret"`, `SELECT * FROM users WHERE token = ?`},
+		{"", `SELECT * FROM users WHERE token = "secret"`, `SELECT * FROM users WHERE token = ?`},
+		{"sqlite", `SELECT * FROM "users" WHERE token = 'secret'`, `SELECT * FROM "users" WHERE token = ?`},
+	}
+	for _, tt := range tests {
+		if got := fsotel.SanitizeStatement(tt.dialect, tt.in); got != tt.want {
+			t.Errorf("SanitizeStatement(%q, %q) = %q, want %q", tt.dialect, tt.in, got, tt.want)
+		}
+	}
+}
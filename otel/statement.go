@@ -0,0 +1,138 @@
+package otel
+
+import (
+	"strings"
+)
+
+// SanitizeStatement, SQL içindeki string ve sayı literal'lerini "?" ile
+// değiştirir. Builder'ın ürettiği SQL zaten placeholder kullanır; bu işlem
+// Raw ifadeler veya ham Transaction sorgularıyla gelen değerlerin
+// db.statement üzerinden dışarı sızmasını engeller. dialect, QueryInfo.Dialect
+// değeridir: çift tırnak yalnızca PostgreSQL ve SQLite'ta tanımlayıcıdır
+// ("users"."email") ve korunur; MySQL/MariaDB'de (ANSI_QUOTES kapalıyken)
+// "..." bir string literal olduğu için '...' gibi gizlenir. Tanınmayan
+// dialect'lerde de çift tırnak literal sayılır. Backtick tanımlayıcılar ve
+// tanımlayıcı içindeki rakamlar (t1, col_2) korunur.
+func SanitizeStatement(dialect, query string) string {
+	quotedIdent := dialect == "postgres" || dialect == "sqlite"
+
+	var sb strings.Builder
+	sb.Grow(len(query))
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' && !quotedIdent:
+			i = skipQuoted(query, i)
+			sb.WriteByte('?')
+		case c == '`' || c == '"':
+			end := skipIdentifier(query, i)
+			sb.WriteString(query[i:end])
+			i = end
+		case isDigit(c) && (i == 0 || !isIdentByte(query[i-1])):
+			i = skipNumber(query, i)
+			sb.WriteByte('?')
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+// skipQuoted, i konumundaki tırnakla başlayan literal'in bittiği konumu döndürür.
+// Ters bölü kaçışları ve tırnağın iki kez yazılmasıyla yapılan kaçışlar desteklenir.
+func skipQuoted(query string, i int) int {
+	quote := query[i]
+	for i++; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+// skipIdentifier, i konumundaki backtick veya çift tırnakla başlayan
+// tanımlayıcının bittiği konumu döndürür. Tanımlayıcılarda ters bölü kaçış
+// değildir; kapanış karakteri yalnızca iki kez yazılarak kaçırılır.
+func skipIdentifier(query string, i int) int {
+	quote := query[i]
+	for i++; i < len(query); i++ {
+		if query[i] == quote {
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+// skipNumber, i konumundaki sayı literal'inin (ondalık, üslü veya 0x onaltılık)
+// bittiği konumu döndürür.
+func skipNumber(query string, i int) int {
+	if strings.HasPrefix(query[i:], "0x") || strings.HasPrefix(query[i:], "0X") {
+		i += 2
+		for i < len(query) && isHexDigit(query[i]) {
+			i++
+		}
+		return i
+	}
+	for i < len(query) {
+		c := query[i]
+		switch {
+		case isDigit(c) || c == '.':
+			i++
+		case (c == 'e' || c == 'E') && i+1 < len(query) && (isDigit(query[i+1]) || query[i+1] == '-' || query[i+1] == '+'):
+			i += 2
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// firstKeyword, baştaki boşluk ve yorumları atlayarak SQL'in ilk anahtar
+// kelimesini büyük harfle döndürür.
+func firstKeyword(query string) string {
+	q := query
+	for {
+		q = strings.TrimLeft(q, " \t\r\n")
+		switch {
+		case strings.HasPrefix(q, "/*"):
+			end := strings.Index(q, "*/")
+			if end < 0 {
+				return ""
+			}
+			q = q[end+2:]
+		case strings.HasPrefix(q, "--"), strings.HasPrefix(q, "#"):
+			end := strings.IndexByte(q, '\n')
+			if end < 0 {
+				return ""
+			}
+			q = q[end+1:]
+		default:
+			end := 0
+			for end < len(q) && isLetter(q[end]) {
+				end++
+			}
+			return strings.ToUpper(q[:end])
+		}
+	}
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+func isHexDigit(c byte) bool { return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' }
+
+func isIdentByte(c byte) bool { return isLetter(c) || isDigit(c) || c == '_' || c == '$' }
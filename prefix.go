@@ -0,0 +1,179 @@
+package fluentsql
+
+import (
+	"strings"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+	"github.com/biyonik/go-fluent-sql/internal/validation"
+)
+
+// -----------------------------------------------------------------------------
+//  Tablo Öneki (Table Prefix)
+//
+//  WithTablePrefix ile verilen önek, Builder'ın grammar'a sunduğu görünüm
+//  üzerinde uygulanır: FROM tablosu, JOIN tabloları, INSERT/UPDATE/DELETE
+//  hedefleri ve "users.id" gibi nitelikli kolonlar. Builder içinde saklanan
+//  değerler hiçbir zaman değiştirilmez; önek yalnızca Get* metotlarının
+//  döndürdüğü kopyalara eklenir. Böylece Clone veya tekrar derleme sırasında
+//  önek iki kez eklenmez.
+//
+//  Kurallar:
+//   • Alias'lar ("users as u" → "u.id") öneklenmez.
+//   • WithUnprefixedTables ile verilen paylaşımlı tablolar öneklenmez.
+//   • Ham ifadeler (boşluk veya parantez içeren kolonlar, Raw) olduğu gibi kalır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// PrefixTable, tek bir tablo adına Builder'ın kurallarıyla önek ekler:
+// "schema.table" biçiminde yalnızca tablo kısmı öneklenir, unprefixed
+// listesindeki tablolar (büyük/küçük harf duyarsız) olduğu gibi kalır.
+// Şema builder'ı gibi sorgu dışı bileşenler aynı kuralları bununla uygular.
+//
+// Örnek:
+//
+//	fluentsql.PrefixTable("acme_", []string{"tenants"}, "users")   // "acme_users"
+//	fluentsql.PrefixTable("acme_", []string{"tenants"}, "tenants") // "tenants"
+func PrefixTable(prefix string, unprefixed []string, name string) string {
+	if prefix == "" {
+		return name
+	}
+	schema := ""
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		schema, name = name[:i+1], name[i+1:]
+	}
+	if isUnprefixed(unprefixed, name) {
+		return schema + name
+	}
+	return schema + prefix + name
+}
+
+// isUnprefixed, tablonun önek dışında bırakılıp bırakılmadığını bildirir.
+func isUnprefixed(unprefixed []string, table string) bool {
+	for _, t := range unprefixed {
+		if strings.EqualFold(t, table) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixName, tek bir tablo adına Builder'ın önekini ekler.
+func (b *Builder) prefixName(name string) string {
+	return PrefixTable(b.prefix, b.unprefixed, name)
+}
+
+// prefixTable, "users" veya "users as u" biçimindeki tablo ifadesine önek ekler.
+// Geçersiz ifadeler olduğu gibi bırakılır; hatayı grammar raporlar.
+func (b *Builder) prefixTable(table string) string {
+	if b.prefix == "" || table == "" {
+		return table
+	}
+
+	name, alias, err := validation.ValidateTableWithAlias(table)
+	if err != nil {
+		return table
+	}
+	if alias != "" {
+		return b.prefixName(name) + " as " + alias
+	}
+	return b.prefixName(name)
+}
+
+// aliases, sorguda tanımlı tablo alias'larını toplar. Bu adlarla nitelenen
+// kolonlar öneklenmez.
+func (b *Builder) aliases() map[string]bool {
+	aliases := make(map[string]bool)
+	if b.tableAlias != "" {
+		aliases[b.tableAlias] = true
+	}
+	if _, alias, err := validation.ValidateTableWithAlias(b.table); err == nil && alias != "" {
+		aliases[alias] = true
+	}
+	for _, join := range b.joins {
+		if join.Alias != "" {
+			aliases[join.Alias] = true
+		}
+		if join.Subquery != nil {
+			continue
+		}
+		if _, alias, err := validation.ValidateTableWithAlias(join.Table); err == nil && alias != "" {
+			aliases[alias] = true
+		}
+	}
+	return aliases
+}
+
+// prefixColumn, "users.id" gibi nitelikli kolonların tablo kısmına önek ekler.
+// Niteliksiz kolonlar, "*" ve ham ifadeler değiştirilmez.
+func (b *Builder) prefixColumn(column string, aliases map[string]bool) string {
+	if b.prefix == "" || !strings.Contains(column, ".") || strings.ContainsAny(column, " ()`'\",") {
+		return column
+	}
+
+	parts := strings.Split(column, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return column
+	}
+
+	// table.column veya schema.table.column → tablo kısmı sondan ikinci parçadır
+	idx := len(parts) - 2
+	table := parts[idx]
+	if aliases[table] || isUnprefixed(b.unprefixed, table) {
+		return column
+	}
+	parts[idx] = b.prefix + table
+	return strings.Join(parts, ".")
+}
+
+// prefixColumns, kolon listesinin öneklenmiş bir kopyasını döndürür.
+func (b *Builder) prefixColumns(columns []string, aliases map[string]bool) []string {
+	if columns == nil {
+		return nil
+	}
+	out := make([]string, len(columns))
+	for i, col := range columns {
+		out[i] = b.prefixColumn(col, aliases)
+	}
+	return out
+}
+
+// prefixFullText, tam metin arama kolonlarının öneklenmiş bir kopyasını döndürür.
+func (b *Builder) prefixFullText(ft *dialect.FullTextClause, aliases map[string]bool) *dialect.FullTextClause {
+	if ft == nil {
+		return nil
+	}
+	cp := *ft
+	cp.Columns = b.prefixColumns(ft.Columns, aliases)
+	return &cp
+}
+
+// prefixWheres, WHERE/HAVING koşullarının öneklenmiş bir kopyasını döndürür.
+func (b *Builder) prefixWheres(wheres []dialect.WhereClause, aliases map[string]bool) []dialect.WhereClause {
+	out := make([]dialect.WhereClause, len(wheres))
+	for i, w := range wheres {
+		w.Column = b.prefixColumn(w.Column, aliases)
+		w.FullText = b.prefixFullText(w.FullText, aliases)
+		if len(w.Nested) > 0 {
+			w.Nested = b.prefixWheres(w.Nested, aliases)
+		}
+		out[i] = w
+	}
+	return out
+}
+
+// prefixData, INSERT/UPDATE haritasındaki nitelikli kolon adlarına önek ekler.
+func (b *Builder) prefixData(data map[string]any) map[string]any {
+	if b.prefix == "" {
+		return data
+	}
+	aliases := b.aliases()
+	out := make(map[string]any, len(data))
+	for k, v := range data {
+		out[b.prefixColumn(k, aliases)] = v
+	}
+	return out
+}
@@ -0,0 +1,209 @@
+// Package prometheus, fluentsql sorgu metriklerini ve bağlantı havuzu
+// istatistiklerini Prometheus'a aktaran bir Collector sunar.
+//
+// Ayrı bir Go modülü olarak sunulur; çekirdek paket yalnızca
+// fluentsql.MetricsRecorder arayüzünü bilir:
+//
+//	import fsprom "github.com/biyonik/go-fluent-sql/prometheus"
+//
+//	collector := fsprom.NewCollector(sqlDB, fsprom.WithNamespace("shop"))
+//	db := fluentsql.NewDB(sqlDB, fluentsql.WithMetrics(collector))
+//	prometheus.MustRegister(collector)
+package prometheus
+
+import (
+	"database/sql"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// -----------------------------------------------------------------------------
+//  Prometheus Collector
+//
+//  Sorgu metrikleri (etiketler: operation, table):
+//   • fluentsql_queries_total                   — çalıştırılan sorgu sayısı
+//   • fluentsql_query_duration_seconds          — sorgu süresi histogramı
+//   • fluentsql_query_errors_total              — hatalı sorgular (+ error_class)
+//
+//  Bağlantı havuzu (sql.DBStats), her scrape anında okunur:
+//   • fluentsql_db_max_open_connections, _open_connections,
+//     _in_use_connections, _idle_connections               (gauge)
+//   • fluentsql_db_wait_count_total, _wait_duration_seconds_total,
+//     _max_idle_closed_total, _max_idle_time_closed_total,
+//     _max_lifetime_closed_total                           (counter)
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Option, Collector yapılandırmasını değiştirir.
+type Option func(*config)
+
+type config struct {
+	namespace   string
+	constLabels prometheus.Labels
+	buckets     []float64
+}
+
+// WithNamespace, metrik adlarının önüne eklenecek namespace'i belirler
+// ("shop" → shop_fluentsql_queries_total).
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
+
+// WithConstLabels, tüm metriklere eklenecek sabit etiketleri belirler.
+// Birden fazla veritabanı izleniyorsa her Collector'a ayırt edici bir
+// etiket (ör. "db": "replica") verilmelidir.
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(c *config) {
+		c.constLabels = labels
+	}
+}
+
+// WithBuckets, süre histogramının kova sınırlarını (saniye) belirler.
+// Varsayılan prometheus.DefBuckets'tır.
+func WithBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.buckets = buckets
+	}
+}
+
+// Collector, fluentsql.MetricsRecorder ve prometheus.Collector arayüzlerini
+// birlikte uygular.
+type Collector struct {
+	db *sql.DB
+
+	queries  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+
+	maxOpen           *prometheus.Desc
+	open              *prometheus.Desc
+	inUse             *prometheus.Desc
+	idle              *prometheus.Desc
+	waitCount         *prometheus.Desc
+	waitDuration      *prometheus.Desc
+	maxIdleClosed     *prometheus.Desc
+	maxIdleTimeClosed *prometheus.Desc
+	maxLifetimeClosed *prometheus.Desc
+}
+
+// Compile-time kontrolü
+var (
+	_ fluentsql.MetricsRecorder = (*Collector)(nil)
+	_ prometheus.Collector      = (*Collector)(nil)
+)
+
+// NewCollector, yeni bir Collector oluşturur. db nil ise yalnızca sorgu
+// metrikleri üretilir, havuz istatistikleri atlanır.
+func NewCollector(db *sql.DB, opts ...Option) *Collector {
+	cfg := config{buckets: prometheus.DefBuckets}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	labels := []string{"operation", "table"}
+	poolDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(cfg.namespace, "fluentsql_db", name), help, nil, cfg.constLabels)
+	}
+
+	return &Collector{
+		db: db,
+		queries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "fluentsql",
+			Name:        "queries_total",
+			Help:        "Total number of executed queries.",
+			ConstLabels: cfg.constLabels,
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "fluentsql",
+			Name:        "query_duration_seconds",
+			Help:        "Query execution latency in seconds.",
+			ConstLabels: cfg.constLabels,
+			Buckets:     cfg.buckets,
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "fluentsql",
+			Name:        "query_errors_total",
+			Help:        "Total number of failed queries by error class.",
+			ConstLabels: cfg.constLabels,
+		}, append(labels, "error_class")),
+
+		maxOpen:           poolDesc("max_open_connections", "Maximum number of open connections to the database."),
+		open:              poolDesc("open_connections", "The number of established connections both in use and idle."),
+		inUse:             poolDesc("in_use_connections", "The number of connections currently in use."),
+		idle:              poolDesc("idle_connections", "The number of idle connections."),
+		waitCount:         poolDesc("wait_count_total", "The total number of connections waited for."),
+		waitDuration:      poolDesc("wait_duration_seconds_total", "The total time blocked waiting for a new connection."),
+		maxIdleClosed:     poolDesc("max_idle_closed_total", "The total number of connections closed due to SetMaxIdleConns."),
+		maxIdleTimeClosed: poolDesc("max_idle_time_closed_total", "The total number of connections closed due to SetConnMaxIdleTime."),
+		maxLifetimeClosed: poolDesc("max_lifetime_closed_total", "The total number of connections closed due to SetConnMaxLifetime."),
+	}
+}
+
+// ObserveQuery, fluentsql.MetricsRecorder arayüzünü uygular.
+func (c *Collector) ObserveQuery(operation, table string, duration time.Duration, err error) {
+	c.queries.WithLabelValues(operation, table).Inc()
+	c.duration.WithLabelValues(operation, table).Observe(duration.Seconds())
+	if err != nil {
+		c.errors.WithLabelValues(operation, table, fluentsql.ErrorClass(err)).Inc()
+	}
+}
+
+// Describe, prometheus.Collector arayüzünü uygular.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.queries.Describe(ch)
+	c.duration.Describe(ch)
+	c.errors.Describe(ch)
+	if c.db == nil {
+		return
+	}
+	for _, d := range c.poolDescs() {
+		ch <- d
+	}
+}
+
+// Collect, prometheus.Collector arayüzünü uygular. Havuz istatistikleri
+// her çağrıda sql.DB.Stats() ile anlık okunur.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.queries.Collect(ch)
+	c.duration.Collect(ch)
+	c.errors.Collect(ch)
+	if c.db == nil {
+		return
+	}
+
+	s := c.db.Stats()
+	gauge := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v)
+	}
+	counter := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, v)
+	}
+
+	gauge(c.maxOpen, float64(s.MaxOpenConnections))
+	gauge(c.open, float64(s.OpenConnections))
+	gauge(c.inUse, float64(s.InUse))
+	gauge(c.idle, float64(s.Idle))
+	counter(c.waitCount, float64(s.WaitCount))
+	counter(c.waitDuration, s.WaitDuration.Seconds())
+	counter(c.maxIdleClosed, float64(s.MaxIdleClosed))
+	counter(c.maxIdleTimeClosed, float64(s.MaxIdleTimeClosed))
+	counter(c.maxLifetimeClosed, float64(s.MaxLifetimeClosed))
+}
+
+func (c *Collector) poolDescs() []*prometheus.Desc {
+	return []*prometheus.Desc{
+		c.maxOpen, c.open, c.inUse, c.idle,
+		c.waitCount, c.waitDuration, c.maxIdleClosed, c.maxIdleTimeClosed, c.maxLifetimeClosed,
+	}
+}
@@ -0,0 +1,77 @@
+package prometheus_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	fsprom "github.com/biyonik/go-fluent-sql/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// nopConnector, bağlantı açmadan yalnızca havuz istatistikleri üreten bir connector'dır.
+type nopConnector struct{}
+
+func (nopConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("nop: no connections")
+}
+func (nopConnector) Driver() driver.Driver { return nil }
+
+func TestCollector(t *testing.T) {
+	db := sql.OpenDB(nopConnector{})
+	defer db.Close()
+	db.SetMaxOpenConns(7)
+
+	c := fsprom.NewCollector(db, fsprom.WithNamespace("shop"), fsprom.WithBuckets([]float64{0.01, 0.1}))
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(c); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	c.ObserveQuery("select", "users", 5*time.Millisecond, nil)
+	c.ObserveQuery("select", "users", 50*time.Millisecond, nil)
+	c.ObserveQuery("update", "orders", time.Millisecond, context.DeadlineExceeded)
+
+	expected := `
+# HELP shop_fluentsql_queries_total Total number of executed queries.
+# TYPE shop_fluentsql_queries_total counter
+shop_fluentsql_queries_total{operation="select",table="users"} 2
+shop_fluentsql_queries_total{operation="update",table="orders"} 1
+# HELP shop_fluentsql_query_errors_total Total number of failed queries by error class.
+# TYPE shop_fluentsql_query_errors_total counter
+shop_fluentsql_query_errors_total{error_class="timeout",operation="update",table="orders"} 1
+# HELP shop_fluentsql_query_duration_seconds Query execution latency in seconds.
+# TYPE shop_fluentsql_query_duration_seconds histogram
+shop_fluentsql_query_duration_seconds_bucket{operation="select",table="users",le="0.01"} 1
+shop_fluentsql_query_duration_seconds_bucket{operation="select",table="users",le="0.1"} 2
+shop_fluentsql_query_duration_seconds_bucket{operation="select",table="users",le="+Inf"} 2
+shop_fluentsql_query_duration_seconds_sum{operation="select",table="users"} 0.055
+shop_fluentsql_query_duration_seconds_count{operation="select",table="users"} 2
+shop_fluentsql_query_duration_seconds_bucket{operation="update",table="orders",le="0.01"} 1
+shop_fluentsql_query_duration_seconds_bucket{operation="update",table="orders",le="0.1"} 1
+shop_fluentsql_query_duration_seconds_bucket{operation="update",table="orders",le="+Inf"} 1
+shop_fluentsql_query_duration_seconds_sum{operation="update",table="orders"} 0.001
+shop_fluentsql_query_duration_seconds_count{operation="update",table="orders"} 1
+# HELP shop_fluentsql_db_max_open_connections Maximum number of open connections to the database.
+# TYPE shop_fluentsql_db_max_open_connections gauge
+shop_fluentsql_db_max_open_connections 7
+`
+	err := testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"shop_fluentsql_queries_total",
+		"shop_fluentsql_query_errors_total",
+		"shop_fluentsql_query_duration_seconds",
+		"shop_fluentsql_db_max_open_connections",
+	)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if n, err := testutil.GatherAndCount(reg); err != nil || n != 2+1+2+9 {
+		t.Errorf("GatherAndCount() = %d, %v; want 14 series", n, err)
+	}
+}
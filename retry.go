@@ -0,0 +1,186 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+// -----------------------------------------------------------------------------
+//  Geçici Hatalarda Otomatik Yeniden Deneme
+//
+//  Deadlock, serialization failure ve lock wait timeout gibi hatalar kalıcı
+//  değildir; aynı işin kısa bir bekleme sonrası tekrarı genellikle başarılı
+//  olur. RetryPolicy bu tekrarları tek yerde tanımlar:
+//
+//   • DB.TransactionWithRetry, transaction fonksiyonunun tamamını yeni bir
+//     transaction içinde yeniden çalıştırır.
+//   • DB.Transaction, WithRetryPolicy ile verilen varsayılan politikayı kullanır.
+//   • Transaction dışındaki Builder yazmaları (Insert, Update, Delete, Upsert,
+//     Increment ...) aynı politikayla ifade bazında yeniden denenir.
+//
+//  Bekleme süresi üstel artar (BaseDelay, 2×, 4× ... MaxDelay) ve her
+//  beklemeye rastgele bir pay eklenir (jitter); böylece çakışan istemciler
+//  aynı anda yeniden denemez. Context iptal edilirse bekleme hemen biter.
+//
+//  Hangi hataların geçici olduğuna grammar'ın ClassifyError sonucu karar
+//  verir; yeni bir dialect kendi hata kodlarını yalnızca orada tanımlar.
+//  Bağlantı koptuğunda (ErrConnectionLost) ifade sunucuda uygulanmış
+//  olabileceğinden bu hata varsayılan olarak yeniden denenmez; yalnızca
+//  idempotent iş yükleri için Retryable ile açıkça etkinleştirilmelidir:
+//
+//	policy.Retryable = func(err error) bool {
+//	    return fluentsql.IsTransient(err) || errors.Is(err, fluentsql.ErrConnectionLost)
+//	}
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// RetryPolicy, yeniden deneme davranışını tanımlar. Sıfır değeri yeniden
+// deneme yapmaz.
+type RetryPolicy struct {
+	MaxAttempts int              // İlk deneme dahil toplam deneme sayısı (≤1: yeniden deneme yok)
+	BaseDelay   time.Duration    // İlk yeniden denemeden önceki bekleme
+	MaxDelay    time.Duration    // Bekleme üst sınırı (0: sınırsız)
+	Retryable   func(error) bool // Hatanın yeniden denenip denenmeyeceği (nil: IsTransient)
+}
+
+// DefaultRetryPolicy, çoğu OLTP iş yükü için makul bir politika döndürür:
+// en fazla 3 deneme, 50ms'den başlayıp 1s'ye kadar artan bekleme.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   50 * time.Millisecond,
+		MaxDelay:    time.Second,
+	}
+}
+
+// IsTransient, hatanın yeniden denendiğinde başarılı olabilecek geçici bir
+// hata olup olmadığını bildirir: deadlock, serialization failure ve lock wait
+// timeout. Kopan bağlantı (ErrConnectionLost) yazmanın uygulanıp
+// uygulanmadığı bilinemediği için geçici sayılmaz.
+//
+// Builder ve Transaction hataları zaten DB'nin grammar'ıyla sınıflandırılmış
+// olarak döner. Sınıflandırılmamış ham sürücü hataları varsayılan MySQL
+// grammar'ıyla sınıflandırılır; bu grammar tanımadığı numaralarda standart
+// SQLSTATE değerine baktığından PostgreSQL kodları da tanınır.
+func IsTransient(err error) bool {
+	return isTransient(defaultGrammar, err)
+}
+
+// defaultGrammar, grammar'ı bilinmeyen hataların sınıflandırılmasında kullanılır.
+var defaultGrammar dialect.Grammar = dialect.NewMySQLGrammar()
+
+// isTransient, err'i grammar ile sınıflandırır ve geçici kategorilerden
+// birine girip girmediğini bildirir.
+func isTransient(grammar dialect.Grammar, err error) bool {
+	if err == nil {
+		return false
+	}
+	err = classifyError(grammar, err)
+	return errors.Is(err, ErrDeadlock) || errors.Is(err, ErrLockTimeout) ||
+		errors.Is(err, ErrSerialization)
+}
+
+// retryable, hatanın bu politika ile yeniden denenip denenmeyeceğini bildirir.
+// Context hataları hiçbir zaman yeniden denenmez.
+func (p RetryPolicy) retryable(grammar dialect.Grammar, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return isTransient(grammar, err)
+}
+
+// backoff, attempt. yeniden denemeden (1'den başlar) önce beklenecek süreyi
+// hesaplar. Süre [d/2, d] aralığında rastgele seçilir (equal jitter).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// do, fn'i politika izin verdiği sürece yeniden çalıştırır. Hatalar
+// grammar ile sınıflandırılır. Context beklemede iptal edilirse son hata ile
+// context hatası birlikte döner.
+func (p RetryPolicy) do(ctx context.Context, grammar dialect.Grammar, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= p.MaxAttempts || !p.retryable(grammar, err) {
+			return err
+		}
+
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// TransactionWithRetry, fn'i bir transaction içinde çalıştırır; transaction
+// geçici bir hata ile biterse (deadlock, serialization failure, lock wait
+// timeout, kopan bağlantı) rollback edilip fn yeni bir transaction içinde tekrar çağrılır.
+//
+// fn birden fazla kez çalışabileceği için veritabanı dışı yan etkileri
+// (e-posta, kuyruk mesajı) transaction commit edildikten sonra yapılmalıdır.
+//
+// ctx bu DB'ye ait açık bir transaction taşıyorsa fn, o transaction içinde
+// iç içe (savepoint'li) çalışır ve yeniden deneme yapılmaz; geçici hatalar
+// dış transaction'ı yöneten çağrıya bırakılır.
+//
+// Örnek:
+//
+//	err := db.TransactionWithRetry(ctx, fluentsql.DefaultRetryPolicy(), func(tx *fluentsql.Transaction) error {
+//	    _, err := tx.Table("stock").Where("sku", "=", sku).DecrementContext(ctx, "qty", 1, nil)
+//	    return err
+//	})
+func (d *DB) TransactionWithRetry(ctx context.Context, policy RetryPolicy, fn func(*Transaction) error) error {
+	return d.transactionWithRetry(ctx, policy, nil, fn)
+}
+
+// transactionWithRetry, TransactionWithRetry'nin sql.TxOptions alan sürümüdür.
+func (d *DB) transactionWithRetry(ctx context.Context, policy RetryPolicy, opts *sql.TxOptions, fn func(*Transaction) error) error {
+	if tx := d.contextTx(ctx); tx != nil {
+		if err := matchTxOptions(tx, opts); err != nil {
+			return err
+		}
+		return tx.Transaction(ctx, fn)
+	}
+	return policy.do(ctx, d.grammar, func() error {
+		return d.transaction(ctx, opts, fn)
+	})
+}
+
+// Retry, bu builder'ın transaction dışı yazmaları için DB'nin
+// varsayılan yeniden deneme politikasını değiştirir.
+//
+// Örnek:
+//
+//	db.Table("counters").Where("id", "=", 1).
+//	    Retry(fluentsql.DefaultRetryPolicy()).
+//	    IncrementContext(ctx, "hits", 1, nil)
+func (b *Builder) Retry(policy RetryPolicy) *Builder {
+	b.retry = policy
+	return b
+}
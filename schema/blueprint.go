@@ -0,0 +1,643 @@
+package schema
+
+import (
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+// -----------------------------------------------------------------------------
+//  Blueprint — Tablo Tanımının Veritabanından Bağımsız Hâli
+//
+//  Blueprint, bir CREATE TABLE veya ALTER TABLE işleminin adımlarını sırasıyla
+//  kaydeder: eklenen sütunlar, index'ler, foreign key'ler ve silme/yeniden
+//  adlandırma komutları. SQL üretmez; bu iş Grammar implementasyonlarına aittir.
+//
+//  Sütun metodları zincirlenebilir bir ColumnDefinition döndürür:
+//
+//	t.String("email", 255).Unique()
+//	t.Decimal("price", 10, 2).Default(0).Comment("KDV hariç")
+//	t.ForeignID("user_id").Constrained("users").OnDelete(schema.Cascade)
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// ColumnType, sütunun veritabanından bağımsız türüdür.
+type ColumnType string
+
+// Sütun türleri. Her Grammar bunları kendi SQL türlerine çevirir.
+const (
+	TypeBigInteger   ColumnType = "bigInteger"
+	TypeInteger      ColumnType = "integer"
+	TypeSmallInteger ColumnType = "smallInteger"
+	TypeTinyInteger  ColumnType = "tinyInteger"
+	TypeString       ColumnType = "string"
+	TypeChar         ColumnType = "char"
+	TypeText         ColumnType = "text"
+	TypeBoolean      ColumnType = "boolean"
+	TypeDecimal      ColumnType = "decimal"
+	TypeFloat        ColumnType = "float"
+	TypeDouble       ColumnType = "double"
+	TypeDate         ColumnType = "date"
+	TypeDateTime     ColumnType = "dateTime"
+	TypeTimestamp    ColumnType = "timestamp"
+	TypeTime         ColumnType = "time"
+	TypeJSON         ColumnType = "json"
+	TypeBinary       ColumnType = "binary"
+	TypeUUID         ColumnType = "uuid"
+	TypeEnum         ColumnType = "enum"
+)
+
+// Foreign key ON DELETE / ON UPDATE davranışları.
+const (
+	Cascade    = "CASCADE"
+	SetNull    = "SET NULL"
+	SetDefault = "SET DEFAULT"
+	Restrict   = "RESTRICT"
+	NoAction   = "NO ACTION"
+)
+
+// Expression, DEFAULT değeri olarak SQL'e olduğu gibi yazılacak ifadedir.
+// Yalnızca güvenilir, sabit ifadeler için kullanılmalıdır.
+type Expression string
+
+// Raw, ham bir DEFAULT ifadesi oluşturur.
+//
+// Örnek:
+//
+//	t.Timestamp("expires_at").Default(schema.Raw("(CURRENT_TIMESTAMP + INTERVAL 1 DAY)"))
+func Raw(expr string) Expression {
+	return Expression(expr)
+}
+
+// Column, tek bir sütunun tanımıdır. Grammar'lar ve şema karşılaştırması
+// bu alanları okur; sütunlar Blueprint metodları ile oluşturulur.
+type Column struct {
+	Name       string
+	Type       ColumnType
+	Length     int      // string/char uzunluğu
+	Precision  int      // decimal toplam basamak
+	Scale      int      // decimal ondalık basamak
+	Values     []string // enum değerleri
+	Unsigned   bool
+	Nullable   bool
+	Default    any // HasDefault true ise geçerlidir; nil → NULL
+	HasDefault bool
+
+	AutoIncrement      bool
+	Primary            bool
+	Unique             bool
+	Index              bool
+	UseCurrent         bool // DEFAULT CURRENT_TIMESTAMP
+	UseCurrentOnUpdate bool // ON UPDATE CURRENT_TIMESTAMP (MySQL)
+	Comment            string
+	After              string // Sütunun ekleneceği konum (MySQL, yalnızca ALTER)
+	Change             bool   // Mevcut sütunu değiştir (yalnızca ALTER)
+}
+
+// IndexType, index türüdür.
+type IndexType string
+
+const (
+	IndexPrimary IndexType = "primary"
+	IndexUnique  IndexType = "unique"
+	IndexPlain   IndexType = "index"
+)
+
+// Index, bir index tanımıdır.
+type Index struct {
+	Name    string
+	Type    IndexType
+	Columns []string
+}
+
+// ForeignKey, bir foreign key tanımıdır.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	References []string
+	On         string // Referans verilen tablo (prefix'siz)
+	OnDelete   string
+	OnUpdate   string
+}
+
+// commandKind, Blueprint'e kaydedilen adımın türüdür.
+type commandKind int
+
+const (
+	cmdAddColumn commandKind = iota
+	cmdDropColumn
+	cmdRenameColumn
+	cmdAddIndex
+	cmdDropIndex
+	cmdDropPrimary
+	cmdAddForeign
+	cmdDropForeign
+)
+
+// command, Blueprint'e kaydedilen tek bir adımdır.
+type command struct {
+	kind    commandKind
+	column  *Column
+	index   *Index
+	foreign *ForeignKey
+	name    string // drop/rename edilen sütun, index veya foreign key adı
+	to      string // rename hedefi
+}
+
+// Blueprint, bir tablonun oluşturulması veya değiştirilmesi için kaydedilen
+// adımlardır. Create ve Table fonksiyonlarına verilen callback'ler ile doldurulur.
+type Blueprint struct {
+	table      string
+	prefix     string
+	unprefixed []string // Prefix uygulanmayacak tablolar (Builder'dan gelir)
+	commands   []command
+
+	engine    string
+	charset   string
+	collation string
+
+	ifNotExists bool // CREATE TABLE IF NOT EXISTS (Builder.CreateIfNotExists)
+	inferred    bool // Go tiplerinden türetildi (FromStruct); Diff tür ailelerini karşılaştırır
+}
+
+// NewBlueprint, verilen tablo için boş bir Blueprint oluşturur. prefix,
+// tablo adına ve foreign key'lerin referans verdiği tablolara eklenir.
+func NewBlueprint(table, prefix string) *Blueprint {
+	return &Blueprint{table: table, prefix: prefix}
+}
+
+// Table, prefix uygulanmış tablo adını döndürür.
+func (b *Blueprint) Table() string {
+	return b.prefixed(b.table)
+}
+
+// prefixed, tablo adına sorgu builder'ıyla aynı kurallarla prefix ekler:
+// "schema.table" biçiminde prefix tablo kısmına eklenir, prefix'siz
+// tablolar olduğu gibi kalır.
+func (b *Blueprint) prefixed(table string) string {
+	return fluentsql.PrefixTable(b.prefix, b.unprefixed, table)
+}
+
+// Columns, eklenen veya değiştirilen sütunları kayıt sırasıyla döndürür.
+func (b *Blueprint) Columns() []Column {
+	var cols []Column
+	for _, c := range b.commands {
+		if c.kind == cmdAddColumn {
+			cols = append(cols, *c.column)
+		}
+	}
+	return cols
+}
+
+// Indexes, açıkça tanımlanan ve sütun düzeyinde (Unique, Index, Primary)
+// işaretlenen index'leri, varsayılan adları atanmış olarak döndürür.
+func (b *Blueprint) Indexes() []Index {
+	var idx []Index
+	for _, c := range b.commands {
+		switch c.kind {
+		case cmdAddColumn:
+			idx = append(idx, b.columnIndexes(c.column)...)
+		case cmdAddIndex:
+			idx = append(idx, b.resolveIndex(*c.index))
+		}
+	}
+	return idx
+}
+
+// ForeignKeys, tanımlanan foreign key'leri varsayılan adları atanmış olarak döndürür.
+func (b *Blueprint) ForeignKeys() []ForeignKey {
+	var fks []ForeignKey
+	for _, c := range b.commands {
+		if c.kind == cmdAddForeign {
+			fks = append(fks, b.resolveForeign(*c.foreign))
+		}
+	}
+	return fks
+}
+
+// columnIndexes, sütun düzeyindeki Primary/Unique/Index işaretlerini index'e çevirir.
+func (b *Blueprint) columnIndexes(col *Column) []Index {
+	var idx []Index
+	if col.Primary {
+		idx = append(idx, b.resolveIndex(Index{Type: IndexPrimary, Columns: []string{col.Name}}))
+	}
+	if col.Unique {
+		idx = append(idx, b.resolveIndex(Index{Type: IndexUnique, Columns: []string{col.Name}}))
+	}
+	if col.Index {
+		idx = append(idx, b.resolveIndex(Index{Type: IndexPlain, Columns: []string{col.Name}}))
+	}
+	return idx
+}
+
+// resolveIndex, adı verilmemiş index'e "tablo_sütunlar_tür" biçiminde ad verir.
+func (b *Blueprint) resolveIndex(idx Index) Index {
+	if idx.Name == "" && idx.Type != IndexPrimary {
+		idx.Name = b.indexName(string(idx.Type), idx.Columns)
+	}
+	return idx
+}
+
+// resolveForeign, adı verilmemiş foreign key'e "tablo_sütunlar_foreign" adını
+// verir ve referans tablosuna prefix ekler.
+func (b *Blueprint) resolveForeign(fk ForeignKey) ForeignKey {
+	if fk.Name == "" {
+		fk.Name = b.indexName("foreign", fk.Columns)
+	}
+	fk.On = b.prefixed(fk.On)
+	if len(fk.References) == 0 {
+		fk.References = []string{"id"}
+	}
+	return fk
+}
+
+// indexName, Laravel ile uyumlu varsayılan index adını üretir.
+func (b *Blueprint) indexName(suffix string, columns []string) string {
+	return strings.ToLower(bareTable(b.Table()) + "_" + strings.Join(columns, "_") + "_" + suffix)
+}
+
+// ---------------------------------------------------------------------------
+// Tablo seçenekleri (MySQL)
+// ---------------------------------------------------------------------------
+
+// Engine, tablonun depolama motorunu belirler (yalnızca MySQL).
+func (b *Blueprint) Engine(engine string) {
+	b.engine = engine
+}
+
+// Charset, tablonun varsayılan karakter setini belirler (yalnızca MySQL).
+func (b *Blueprint) Charset(charset string) {
+	b.charset = charset
+}
+
+// Collation, tablonun varsayılan collation'ını belirler (yalnızca MySQL).
+func (b *Blueprint) Collation(collation string) {
+	b.collation = collation
+}
+
+// ---------------------------------------------------------------------------
+// Sütunlar
+// ---------------------------------------------------------------------------
+
+// addColumn, sütunu komut listesine ekler ve zincirleme tanım döndürür.
+func (b *Blueprint) addColumn(name string, typ ColumnType) *ColumnDefinition {
+	col := &Column{Name: name, Type: typ}
+	b.commands = append(b.commands, command{kind: cmdAddColumn, column: col})
+	return &ColumnDefinition{col: col, bp: b}
+}
+
+// ID, "id" adında otomatik artan BIGINT UNSIGNED primary key ekler.
+func (b *Blueprint) ID() *ColumnDefinition {
+	return b.BigIncrements("id")
+}
+
+// BigIncrements, otomatik artan BIGINT UNSIGNED primary key ekler.
+func (b *Blueprint) BigIncrements(name string) *ColumnDefinition {
+	return b.BigInteger(name).Unsigned().AutoIncrement().Primary()
+}
+
+// Increments, otomatik artan INT UNSIGNED primary key ekler.
+func (b *Blueprint) Increments(name string) *ColumnDefinition {
+	return b.Integer(name).Unsigned().AutoIncrement().Primary()
+}
+
+// BigInteger, BIGINT sütun ekler.
+func (b *Blueprint) BigInteger(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeBigInteger)
+}
+
+// Integer, INT sütun ekler.
+func (b *Blueprint) Integer(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeInteger)
+}
+
+// SmallInteger, SMALLINT sütun ekler.
+func (b *Blueprint) SmallInteger(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeSmallInteger)
+}
+
+// TinyInteger, TINYINT sütun ekler.
+func (b *Blueprint) TinyInteger(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeTinyInteger)
+}
+
+// ForeignID, foreign key için BIGINT UNSIGNED sütun ekler. Constrained ile
+// referans verdiği tabloya bağlanabilir.
+func (b *Blueprint) ForeignID(name string) *ColumnDefinition {
+	return b.BigInteger(name).Unsigned()
+}
+
+// String, VARCHAR sütun ekler. Uzunluk verilmezse 255 kullanılır.
+func (b *Blueprint) String(name string, length ...int) *ColumnDefinition {
+	c := b.addColumn(name, TypeString)
+	c.col.Length = 255
+	if len(length) > 0 {
+		c.col.Length = length[0]
+	}
+	return c
+}
+
+// Char, sabit uzunlukta CHAR sütun ekler.
+func (b *Blueprint) Char(name string, length int) *ColumnDefinition {
+	c := b.addColumn(name, TypeChar)
+	c.col.Length = length
+	return c
+}
+
+// Text, TEXT sütun ekler.
+func (b *Blueprint) Text(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeText)
+}
+
+// Boolean, mantıksal sütun ekler.
+func (b *Blueprint) Boolean(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeBoolean)
+}
+
+// Decimal, sabit hassasiyetli DECIMAL sütun ekler.
+func (b *Blueprint) Decimal(name string, precision, scale int) *ColumnDefinition {
+	c := b.addColumn(name, TypeDecimal)
+	c.col.Precision, c.col.Scale = precision, scale
+	return c
+}
+
+// Float, tek hassasiyetli kayan noktalı sütun ekler.
+func (b *Blueprint) Float(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeFloat)
+}
+
+// Double, çift hassasiyetli kayan noktalı sütun ekler.
+func (b *Blueprint) Double(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeDouble)
+}
+
+// Date, DATE sütun ekler.
+func (b *Blueprint) Date(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeDate)
+}
+
+// DateTime, DATETIME sütun ekler.
+func (b *Blueprint) DateTime(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeDateTime)
+}
+
+// Timestamp, TIMESTAMP sütun ekler.
+func (b *Blueprint) Timestamp(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeTimestamp)
+}
+
+// Time, TIME sütun ekler.
+func (b *Blueprint) Time(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeTime)
+}
+
+// JSON, JSON sütun ekler (PostgreSQL'de JSONB, SQLite'ta TEXT).
+func (b *Blueprint) JSON(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeJSON)
+}
+
+// Binary, ikili veri (BLOB/BYTEA) sütunu ekler.
+func (b *Blueprint) Binary(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeBinary)
+}
+
+// UUID, UUID sütun ekler (MySQL'de CHAR(36)).
+func (b *Blueprint) UUID(name string) *ColumnDefinition {
+	return b.addColumn(name, TypeUUID)
+}
+
+// Enum, yalnızca verilen değerleri kabul eden sütun ekler. MySQL'de ENUM,
+// diğer veritabanlarında CHECK kısıtlı VARCHAR olarak oluşturulur.
+func (b *Blueprint) Enum(name string, values ...string) *ColumnDefinition {
+	c := b.addColumn(name, TypeEnum)
+	c.col.Values = values
+	return c
+}
+
+// Timestamps, NULL olabilen created_at ve updated_at sütunlarını ekler.
+func (b *Blueprint) Timestamps() {
+	b.Timestamp("created_at").Nullable()
+	b.Timestamp("updated_at").Nullable()
+}
+
+// SoftDeletes, NULL olabilen deleted_at sütununu ekler.
+func (b *Blueprint) SoftDeletes() {
+	b.Timestamp("deleted_at").Nullable()
+}
+
+// ---------------------------------------------------------------------------
+// Index'ler ve foreign key'ler
+// ---------------------------------------------------------------------------
+
+// addIndex, index komutunu kaydeder.
+func (b *Blueprint) addIndex(typ IndexType, columns []string) *IndexDefinition {
+	idx := &Index{Type: typ, Columns: columns}
+	b.commands = append(b.commands, command{kind: cmdAddIndex, index: idx})
+	return &IndexDefinition{index: idx}
+}
+
+// Primary, birleşik (composite) primary key tanımlar.
+func (b *Blueprint) Primary(columns ...string) *IndexDefinition {
+	return b.addIndex(IndexPrimary, columns)
+}
+
+// Unique, unique index tanımlar.
+func (b *Blueprint) Unique(columns ...string) *IndexDefinition {
+	return b.addIndex(IndexUnique, columns)
+}
+
+// Index, sıradan bir index tanımlar.
+func (b *Blueprint) Index(columns ...string) *IndexDefinition {
+	return b.addIndex(IndexPlain, columns)
+}
+
+// Foreign, foreign key tanımlar.
+//
+// Örnek:
+//
+//	t.Foreign("user_id").References("id").On("users").OnDelete(schema.Cascade)
+func (b *Blueprint) Foreign(columns ...string) *ForeignKeyDefinition {
+	fk := &ForeignKey{Columns: columns}
+	b.commands = append(b.commands, command{kind: cmdAddForeign, foreign: fk})
+	return &ForeignKeyDefinition{fk: fk}
+}
+
+// ---------------------------------------------------------------------------
+// ALTER komutları
+// ---------------------------------------------------------------------------
+
+// DropColumn, sütunları siler.
+func (b *Blueprint) DropColumn(names ...string) {
+	for _, name := range names {
+		b.commands = append(b.commands, command{kind: cmdDropColumn, name: name})
+	}
+}
+
+// RenameColumn, sütunu yeniden adlandırır.
+func (b *Blueprint) RenameColumn(from, to string) {
+	b.commands = append(b.commands, command{kind: cmdRenameColumn, name: from, to: to})
+}
+
+// DropIndex, index'i adıyla siler.
+func (b *Blueprint) DropIndex(name string) {
+	b.commands = append(b.commands, command{kind: cmdDropIndex, name: name})
+}
+
+// DropUnique, unique index'i adıyla siler.
+func (b *Blueprint) DropUnique(name string) {
+	b.DropIndex(name)
+}
+
+// DropPrimary, primary key'i siler.
+func (b *Blueprint) DropPrimary() {
+	b.commands = append(b.commands, command{kind: cmdDropPrimary})
+}
+
+// DropForeign, foreign key'i adıyla siler.
+func (b *Blueprint) DropForeign(name string) {
+	b.commands = append(b.commands, command{kind: cmdDropForeign, name: name})
+}
+
+// ---------------------------------------------------------------------------
+// Zincirleme tanımlar
+// ---------------------------------------------------------------------------
+
+// ColumnDefinition, bir sütunun özelliklerini zincirleme belirlemek için kullanılır.
+type ColumnDefinition struct {
+	col *Column
+	bp  *Blueprint
+}
+
+// Nullable, sütunun NULL değer almasına izin verir.
+func (c *ColumnDefinition) Nullable() *ColumnDefinition {
+	c.col.Nullable = true
+	return c
+}
+
+// Default, sütunun varsayılan değerini belirler. string, sayı, bool, nil
+// veya Expression olabilir.
+func (c *ColumnDefinition) Default(value any) *ColumnDefinition {
+	c.col.Default, c.col.HasDefault = value, true
+	return c
+}
+
+// Unsigned, sayısal sütunu işaretsiz yapar (yalnızca MySQL).
+func (c *ColumnDefinition) Unsigned() *ColumnDefinition {
+	c.col.Unsigned = true
+	return c
+}
+
+// AutoIncrement, sütunu otomatik artan yapar.
+func (c *ColumnDefinition) AutoIncrement() *ColumnDefinition {
+	c.col.AutoIncrement = true
+	return c
+}
+
+// Primary, sütunu primary key yapar.
+func (c *ColumnDefinition) Primary() *ColumnDefinition {
+	c.col.Primary = true
+	return c
+}
+
+// Unique, sütun için unique index oluşturur.
+func (c *ColumnDefinition) Unique() *ColumnDefinition {
+	c.col.Unique = true
+	return c
+}
+
+// Index, sütun için index oluşturur.
+func (c *ColumnDefinition) Index() *ColumnDefinition {
+	c.col.Index = true
+	return c
+}
+
+// UseCurrent, varsayılan değeri CURRENT_TIMESTAMP yapar.
+func (c *ColumnDefinition) UseCurrent() *ColumnDefinition {
+	c.col.UseCurrent = true
+	return c
+}
+
+// UseCurrentOnUpdate, satır her güncellendiğinde değeri CURRENT_TIMESTAMP
+// yapar (yalnızca MySQL).
+func (c *ColumnDefinition) UseCurrentOnUpdate() *ColumnDefinition {
+	c.col.UseCurrentOnUpdate = true
+	return c
+}
+
+// Comment, sütuna açıklama ekler.
+func (c *ColumnDefinition) Comment(comment string) *ColumnDefinition {
+	c.col.Comment = comment
+	return c
+}
+
+// After, sütunu verilen sütundan sonra ekler (yalnızca MySQL ALTER).
+func (c *ColumnDefinition) After(column string) *ColumnDefinition {
+	c.col.After = column
+	return c
+}
+
+// Change, ALTER sırasında yeni sütun eklemek yerine mevcut sütunu tanıma göre değiştirir.
+func (c *ColumnDefinition) Change() *ColumnDefinition {
+	c.col.Change = true
+	return c
+}
+
+// Constrained, sütun için foreign key tanımlar. Tablo verilmezse sütun
+// adından türetilir ("user_id" → "users").
+func (c *ColumnDefinition) Constrained(table ...string) *ForeignKeyDefinition {
+	on := strings.TrimSuffix(c.col.Name, "_id") + "s"
+	if len(table) > 0 {
+		on = table[0]
+	}
+	return c.bp.Foreign(c.col.Name).References("id").On(on)
+}
+
+// IndexDefinition, bir index'in özelliklerini zincirleme belirlemek için kullanılır.
+type IndexDefinition struct {
+	index *Index
+}
+
+// Name, index'in adını belirler.
+func (i *IndexDefinition) Name(name string) *IndexDefinition {
+	i.index.Name = name
+	return i
+}
+
+// ForeignKeyDefinition, bir foreign key'in özelliklerini zincirleme belirlemek için kullanılır.
+type ForeignKeyDefinition struct {
+	fk *ForeignKey
+}
+
+// References, referans verilen sütunları belirler (varsayılan: "id").
+func (f *ForeignKeyDefinition) References(columns ...string) *ForeignKeyDefinition {
+	f.fk.References = columns
+	return f
+}
+
+// On, referans verilen tabloyu belirler.
+func (f *ForeignKeyDefinition) On(table string) *ForeignKeyDefinition {
+	f.fk.On = table
+	return f
+}
+
+// OnDelete, referans verilen satır silindiğinde yapılacak işlemi belirler.
+func (f *ForeignKeyDefinition) OnDelete(action string) *ForeignKeyDefinition {
+	f.fk.OnDelete = action
+	return f
+}
+
+// OnUpdate, referans verilen anahtar güncellendiğinde yapılacak işlemi belirler.
+func (f *ForeignKeyDefinition) OnUpdate(action string) *ForeignKeyDefinition {
+	f.fk.OnUpdate = action
+	return f
+}
+
+// Name, foreign key constraint'inin adını belirler.
+func (f *ForeignKeyDefinition) Name(name string) *ForeignKeyDefinition {
+	f.fk.Name = name
+	return f
+}
@@ -0,0 +1,663 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+// -----------------------------------------------------------------------------
+//  Şema Farkı — Tanımdan Canlı Şemaya ALTER İfadeleri
+//
+//  Diff, bir Blueprint'i (elle yazılmış veya FromStruct ile Go struct'ından
+//  türetilmiş) DB.Schema() ile okunan canlı tabloyla karşılaştırır ve ikisini
+//  eşitleyen ifadeleri üretir:
+//
+//   • tablo yoksa CREATE TABLE,
+//   • eksik sütunlar için ADD COLUMN,
+//   • türü veya NULL'luğu farklı sütunlar için Change() (MODIFY / ALTER TYPE),
+//   • eksik index'ler için ADD INDEX / CREATE INDEX.
+//
+//  Türler ham metin olarak değil, aileleriyle karşılaştırılır: "INT",
+//  "integer" ve "int(11)" aynıdır; "VARCHAR(255)" ile "character varying(100)"
+//  farklıdır. Default değerler ve yorumlar karşılaştırılmaz; değiştirilen
+//  sütunlarda canlı default ve yorum korunur. Otomatik artan sütunlara
+//  dokunulmaz.
+//
+//  Canlı şemada olup tanımda olmayan sütun ve index'lerin silinmesi veri
+//  kaybettirebilir; bu ifadeler yalnızca DiffOptions.AllowDrops ile
+//  Statements'a girer, aksi halde Skipped'da raporlanır. Aynı şekilde
+//  daraltan (VARCHAR(255) → VARCHAR(50), BIGINT → INT) veya tür ailesini
+//  değiştiren (TEXT → INT) sütun değişiklikleri yalnızca
+//  DiffOptions.AllowLossyChanges ile uygulanır.
+//
+//  Dialect'in ifade edemediği sütun değişiklikleri (SQLite'ta sütun
+//  değiştirmek tabloyu yeniden oluşturmayı gerektirir) farkı iptal etmez;
+//  sütunlar Unsupported'da raporlanır ve geri kalan ifadeler üretilir.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// DiffOptions, Diff'in davranışını belirler.
+type DiffOptions struct {
+	// AllowDrops, tanımda olmayan sütun ve index'lerin silinmesine ve
+	// primary key'in değiştirilmesine izin verir.
+	AllowDrops bool
+
+	// AllowLossyChanges, sütun türünü daraltan veya tür ailesini
+	// değiştiren ve bu yüzden veri kaybettirebilecek ya da mevcut satırlarda
+	// başarısız olabilecek değişikliklere izin verir.
+	AllowLossyChanges bool
+}
+
+// TableDiff, tek bir tablonun tanım ile canlı şema arasındaki farkıdır.
+type TableDiff struct {
+	Table      string   // Prefix uygulanmış tablo adı
+	Create     bool     // Tablo yok; Statements CREATE TABLE ifadeleridir
+	Statements []string // Çalıştırılacak ifadeler
+	Skipped    []string // AllowDrops veya AllowLossyChanges verilmediği için atlanan ifadeler
+
+	// Unsupported, dialect'in değiştiremediği için atlanan sütunlardır
+	// (ör. SQLite'ta tür veya NULL'luk değişikliği).
+	Unsupported []string
+}
+
+// Empty, tanım ile canlı şema arasında fark olup olmadığını bildirir.
+func (d *TableDiff) Empty() bool {
+	return len(d.Statements) == 0 && len(d.Skipped) == 0 && len(d.Unsupported) == 0
+}
+
+// Diff, bp'yi inspector'ın okuduğu canlı tabloyla karşılaştırır. bp'deki
+// yalnızca sütun ve index tanımları okunur; foreign key'ler karşılaştırılmaz.
+//
+// Örnek:
+//
+//	bp, _ := schema.FromStruct("", db.TablePrefix(), User{})
+//	d, err := schema.New(db).Diff(ctx, db.Schema(), bp, schema.DiffOptions{})
+func (b *Builder) Diff(ctx context.Context, inspector *fluentsql.SchemaInspector, bp *Blueprint, opts DiffOptions) (*TableDiff, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	table := bp.Table()
+	d := &TableDiff{Table: table}
+
+	exists, err := inspector.HasTable(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		stmts, err := b.grammar.CompileCreate(bp)
+		if err != nil {
+			return nil, fluentsql.NewQueryError("diff", table, "", err)
+		}
+		d.Create, d.Statements = true, stmts
+		return d, nil
+	}
+
+	liveCols, err := inspector.Columns(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	liveIdx, err := inspector.Indexes(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	alter := NewBlueprint(bp.table, bp.prefix)
+	lossy := NewBlueprint(bp.table, bp.prefix)
+	drops := NewBlueprint(bp.table, bp.prefix)
+	alter.unprefixed, lossy.unprefixed, drops.unprefixed = bp.unprefixed, bp.unprefixed, bp.unprefixed
+	if err := b.diffColumns(bp, liveCols, alter, lossy, drops); err != nil {
+		return nil, fluentsql.NewQueryError("diff", table, "", err)
+	}
+	diffIndexes(bp, liveIdx, alter, drops)
+
+	if d.Statements, d.Unsupported, err = b.compileChanges(alter); err != nil {
+		return nil, fluentsql.NewQueryError("diff", table, "", err)
+	}
+	if len(lossy.commands) > 0 {
+		stmts, unsupported, err := b.compileChanges(lossy)
+		if err != nil {
+			return nil, fluentsql.NewQueryError("diff", table, "", err)
+		}
+		d.Unsupported = append(d.Unsupported, unsupported...)
+		if opts.AllowLossyChanges {
+			d.Statements = append(d.Statements, stmts...)
+		} else {
+			d.Skipped = append(d.Skipped, stmts...)
+		}
+	}
+	if len(drops.commands) > 0 {
+		stmts, err := b.grammar.CompileAlter(drops)
+		if err != nil {
+			return nil, fluentsql.NewQueryError("diff", table, "", err)
+		}
+		if opts.AllowDrops {
+			d.Statements = append(d.Statements, stmts...)
+		} else {
+			d.Skipped = append(d.Skipped, stmts...)
+		}
+	}
+	return d, nil
+}
+
+// compileChanges, bp'yi derler. Grammar sütun değişikliğini ifade edemezse
+// (ErrNotSupported) değişen sütunlar çıkarılıp adları döndürülür ve kalan
+// komutlar yeniden derlenir.
+func (b *Builder) compileChanges(bp *Blueprint) ([]string, []string, error) {
+	stmts, err := b.grammar.CompileAlter(bp)
+	if err == nil || !errors.Is(err, ErrNotSupported) {
+		return stmts, nil, err
+	}
+
+	var changed []string
+	kept := make([]command, 0, len(bp.commands))
+	for _, c := range bp.commands {
+		if c.kind == cmdAddColumn && c.column.Change {
+			changed = append(changed, c.column.Name)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	if len(changed) == 0 {
+		return nil, nil, err
+	}
+	bp.commands = kept
+	if len(kept) == 0 {
+		return nil, changed, nil
+	}
+	stmts, err = b.grammar.CompileAlter(bp)
+	return stmts, changed, err
+}
+
+// diffColumns, eksik ve farklı sütunları alter'a, veri kaybettirebilecek
+// değişiklikleri lossy'ye, fazla sütunları drops'a ekler.
+func (b *Builder) diffColumns(bp *Blueprint, live []fluentsql.ColumnInfo, alter, lossy, drops *Blueprint) error {
+	byName := make(map[string]fluentsql.ColumnInfo, len(live))
+	for _, c := range live {
+		byName[strings.ToLower(c.Name)] = c
+	}
+
+	declared := map[string]bool{}
+	for _, col := range bp.Columns() {
+		if col.Change {
+			continue
+		}
+		declared[strings.ToLower(col.Name)] = true
+
+		// Index'ler diffIndexes'te karşılaştırılır
+		c := col
+		c.Primary, c.Unique, c.Index = false, false, false
+
+		have, ok := byName[strings.ToLower(c.Name)]
+		if !ok {
+			alter.commands = append(alter.commands, command{kind: cmdAddColumn, column: &c})
+			continue
+		}
+		changed, narrows, err := b.changedColumn(bp.inferred, &c, have)
+		if err != nil {
+			return err
+		}
+		if changed {
+			c.Change, c.After = true, ""
+			preserveColumn(&c, have, b.grammar.Name())
+			target := alter
+			if narrows {
+				target = lossy
+			}
+			target.commands = append(target.commands, command{kind: cmdAddColumn, column: &c})
+		}
+	}
+
+	for _, c := range live {
+		if !declared[strings.ToLower(c.Name)] {
+			drops.DropColumn(c.Name)
+		}
+	}
+	return nil
+}
+
+// changedColumn, sütunun canlı tanımdan farklı olup olmadığını ve
+// değişikliğin türü daraltıp daraltmadığını (bkz. narrowsType) bildirir.
+// Go tiplerinden türetilen tanımlarda yalnızca tür aileleri karşılaştırılır;
+// aile aynıysa ve yalnızca NULL'luk farklıysa col canlı türü alır, böylece
+// örneğin INT bir sütun int64 alan yüzünden BIGINT'e çevrilmez.
+func (b *Builder) changedColumn(inferred bool, col *Column, have fluentsql.ColumnInfo) (bool, bool, error) {
+	if have.AutoIncrement {
+		return false, false, nil
+	}
+	typ, err := columnType(b.grammar, *col)
+	if err != nil {
+		return false, false, err
+	}
+	want, got := canonicalType(typ), canonicalType(have.FullType)
+
+	if !inferred {
+		unsigned := b.grammar.Name() == "mysql" && isNumeric(col.Type) &&
+			col.Unsigned != strings.Contains(strings.ToLower(have.FullType), "unsigned")
+		changed := want != got || unsigned || col.Nullable != have.Nullable
+		return changed, changed && narrowsType(want, got), nil
+	}
+
+	if typeClass(want) != typeClass(got) {
+		return true, true, nil
+	}
+	// []byte, NULL'u nil ile ifade eder; NULL'luğu belirsizdir
+	if col.Type == TypeBinary || col.Nullable == have.Nullable {
+		return false, false, nil
+	}
+	liveCol, ok := columnFromType(got)
+	if !ok {
+		return false, false, nil
+	}
+	col.Type, col.Length, col.Precision, col.Scale = liveCol.Type, liveCol.Length, liveCol.Precision, liveCol.Scale
+	col.Unsigned = strings.Contains(strings.ToLower(have.FullType), "unsigned")
+	return true, false, nil
+}
+
+// typeRanks, aynı ailedeki türlerin genişlik sırasıdır.
+var typeRanks = map[string]int{
+	"bool": 0, "tinyint": 1, "smallint": 2, "int": 3, "bigint": 4,
+	"float": 1, "double": 2,
+	"date": 1, "datetime": 2,
+}
+
+// narrowsType, canlı got türünden want türüne geçişin veri kaybettirip
+// kaybettirmeyeceğini bildirir: farklı tür ailesi, daha dar tamsayı veya
+// kayan nokta, DATETIME → DATE, daha kısa metin uzunluğu ya da daha küçük
+// DECIMAL hassasiyeti.
+func narrowsType(want, got string) bool {
+	if typeClass(want) != typeClass(got) {
+		return true
+	}
+	wantBase, wantArgs, _ := strings.Cut(want, "(")
+	gotBase, gotArgs, _ := strings.Cut(got, "(")
+	if wr, ok := typeRanks[wantBase]; ok {
+		gr, ok := typeRanks[gotBase]
+		return ok && wr < gr
+	}
+
+	if (wantBase == "decimal") != (gotBase == "decimal") {
+		return true
+	}
+	if wantBase == "decimal" {
+		wp, ws := typeArgs(wantArgs)
+		gp, gs := typeArgs(gotArgs)
+		return ws < gs || wp-ws < gp-gs
+	}
+	return textLength(wantBase, wantArgs) < textLength(gotBase, gotArgs)
+}
+
+// typeArgs, "10,2)" biçimindeki tür argümanlarının ilk ikisini döndürür.
+func typeArgs(args string) (int, int) {
+	first, second, _ := strings.Cut(strings.TrimSuffix(args, ")"), ",")
+	a, _ := strconv.Atoi(first)
+	b, _ := strconv.Atoi(second)
+	return a, b
+}
+
+// textLength, metin türünün en fazla uzunluğunu döndürür; uzunluğu
+// sınırlanmamış türler (TEXT, JSON ...) için math.MaxInt döner.
+func textLength(base, args string) int {
+	if base != "varchar" && base != "char" {
+		return math.MaxInt
+	}
+	if n, _ := typeArgs(args); n > 0 {
+		return n
+	}
+	return math.MaxInt
+}
+
+// preserveColumn, değiştirilen sütunun tanımda verilmeyen default değerini ve
+// yorumunu canlı şemadan alır; MODIFY COLUMN bunları aksi halde siler.
+func preserveColumn(col *Column, have fluentsql.ColumnInfo, dialect string) {
+	if col.Comment == "" {
+		col.Comment = have.Comment
+	}
+	if col.HasDefault || col.UseCurrent || have.Default == nil {
+		return
+	}
+	v := *have.Default
+	switch {
+	case strings.HasPrefix(strings.ToLower(v), "current_timestamp"):
+		col.UseCurrent = true
+	case dialect == "mysql":
+		// information_schema default'u tırnaksız değer olarak verir
+		col.Default, col.HasDefault = v, true
+	default:
+		col.Default, col.HasDefault = Raw(v), true
+	}
+}
+
+// diffIndexes, eksik index'leri alter'a; fazla index'leri ve primary key
+// değişikliğini drops'a ekler. Index'ler adlarıyla değil türleri ve
+// sütunlarıyla eşleştirilir.
+func diffIndexes(bp *Blueprint, live []fluentsql.IndexInfo, alter, drops *Blueprint) {
+	liveKeys := map[string]bool{}
+	hasPrimary := false
+	for _, idx := range live {
+		liveKeys[indexKey(idx.Primary, idx.Unique, idx.Columns)] = true
+		hasPrimary = hasPrimary || idx.Primary
+	}
+
+	declared := map[string]bool{}
+	for _, idx := range bp.Indexes() {
+		key := indexKey(idx.Type == IndexPrimary, idx.Type != IndexPlain, idx.Columns)
+		declared[key] = true
+		if liveKeys[key] {
+			continue
+		}
+		i := idx
+		if idx.Type == IndexPrimary && hasPrimary {
+			drops.DropPrimary()
+			drops.commands = append(drops.commands, command{kind: cmdAddIndex, index: &i})
+			continue
+		}
+		alter.commands = append(alter.commands, command{kind: cmdAddIndex, index: &i})
+	}
+
+	// Go struct'ları index bilgisi taşımaz; türetilmiş tanımlarda index silinmez
+	if bp.inferred {
+		return
+	}
+	for _, idx := range live {
+		if idx.Primary || strings.HasPrefix(idx.Name, "sqlite_autoindex_") {
+			continue
+		}
+		if !declared[indexKey(false, idx.Unique, idx.Columns)] {
+			drops.DropIndex(idx.Name)
+		}
+	}
+}
+
+// indexKey, index'i türü ve sütunlarıyla tanımlayan anahtardır.
+func indexKey(primary, unique bool, columns []string) string {
+	kind := "index"
+	switch {
+	case primary:
+		kind = "primary"
+	case unique:
+		kind = "unique"
+	}
+	return kind + ":" + strings.ToLower(strings.Join(columns, ","))
+}
+
+// columnType, sütunun grammar'daki SQL türünü döndürür.
+func columnType(g Grammar, col Column) (string, error) {
+	switch g := g.(type) {
+	case *MySQLGrammar:
+		return g.typeFor(col)
+	case *PostgresGrammar:
+		return g.typeFor(col, false)
+	case *SQLiteGrammar:
+		return g.typeFor(col)
+	default:
+		return "", notSupported(g.Name(), "schema diff")
+	}
+}
+
+// typeAliases, veritabanlarının aynı tür için kullandığı adları ortak bir
+// ada eşler.
+var typeAliases = map[string]string{
+	"integer": "int", "int4": "int", "mediumint": "int", "serial": "int",
+	"int8": "bigint", "bigserial": "bigint",
+	"int2": "smallint", "smallserial": "smallint",
+	"boolean": "bool",
+	"numeric": "decimal",
+	"real":    "float", "float4": "float",
+	"double precision": "double", "float8": "double",
+	"timestamp": "datetime", "timestamptz": "datetime",
+	"timestamp without time zone": "datetime", "timestamp with time zone": "datetime",
+	"time without time zone": "time",
+	"character varying":      "varchar", "character": "char", "bpchar": "char",
+	"tinytext": "text", "mediumtext": "text", "longtext": "text",
+	"jsonb": "json",
+	"bytea": "blob", "tinyblob": "blob", "mediumblob": "blob", "longblob": "blob",
+	"binary": "blob", "varbinary": "blob",
+}
+
+// canonicalType, bir SQL türünü karşılaştırılabilir biçime getirir:
+// "INT(11) UNSIGNED" → "int", "character varying(255)" → "varchar(255)",
+// "NUMERIC(10, 2)" → "decimal(10,2)". Uzunluk yalnızca metin ve decimal
+// türlerinde anlamlıdır; tamsayı görüntüleme genişliği atılır.
+func canonicalType(typ string) string {
+	t := strings.ToLower(strings.TrimSpace(typ))
+	if t == "tinyint(1)" || strings.HasPrefix(t, "tinyint(1) ") {
+		return "bool"
+	}
+
+	args := ""
+	if i := strings.IndexByte(t, '('); i >= 0 {
+		if j := strings.IndexByte(t[i:], ')'); j >= 0 {
+			args = strings.ReplaceAll(t[i+1:i+j], " ", "")
+			t = t[:i] + " " + t[i+j+1:]
+		}
+	}
+	var words []string
+	for _, w := range strings.Fields(t) {
+		if w != "unsigned" && w != "zerofill" {
+			words = append(words, w)
+		}
+	}
+	base := strings.Join(words, " ")
+	if alias, ok := typeAliases[base]; ok {
+		base = alias
+	}
+
+	switch base {
+	case "varchar", "char", "decimal":
+		if args != "" {
+			return base + "(" + args + ")"
+		}
+	}
+	return base
+}
+
+// typeClass, kanonik türün Go tarafındaki karşılık ailesini döndürür.
+func typeClass(canonical string) string {
+	base, _, _ := strings.Cut(canonical, "(")
+	switch base {
+	case "bigint", "int", "smallint", "tinyint", "bool":
+		// SQLite BOOLEAN'ı INTEGER olarak oluşturur; bool ve tamsayı aynı ailedir
+		return "int"
+	case "float", "double":
+		return "float"
+	case "date", "datetime":
+		return "time"
+	case "blob":
+		return "bytes"
+	default:
+		return "string"
+	}
+}
+
+// columnFromType, kanonik türden Blueprint sütun türünü kurar. ENUM gibi
+// değer listesi gerektiren türler için false döner.
+func columnFromType(canonical string) (Column, bool) {
+	base, args, _ := strings.Cut(canonical, "(")
+	var nums []int
+	for _, a := range strings.Split(strings.TrimSuffix(args, ")"), ",") {
+		if n, err := strconv.Atoi(a); err == nil {
+			nums = append(nums, n)
+		}
+	}
+
+	types := map[string]ColumnType{
+		"bigint": TypeBigInteger, "int": TypeInteger, "smallint": TypeSmallInteger,
+		"tinyint": TypeTinyInteger, "bool": TypeBoolean, "text": TypeText,
+		"float": TypeFloat, "double": TypeDouble, "date": TypeDate,
+		"datetime": TypeDateTime, "time": TypeTime, "json": TypeJSON,
+		"blob": TypeBinary, "uuid": TypeUUID,
+	}
+	switch base {
+	case "varchar", "char":
+		if len(nums) != 1 {
+			return Column{}, false
+		}
+		typ := TypeString
+		if base == "char" {
+			typ = TypeChar
+		}
+		return Column{Type: typ, Length: nums[0]}, true
+	case "decimal":
+		if len(nums) != 2 {
+			return Column{}, false
+		}
+		return Column{Type: TypeDecimal, Precision: nums[0], Scale: nums[1]}, true
+	}
+	typ, ok := types[base]
+	return Column{Type: typ}, ok
+}
+
+// -----------------------------------------------------------------------------
+//  Go Struct'larından Blueprint
+//
+//  FromStruct, DefaultScanner'ın kurallarıyla (`db:"kolon"`, `db:"kolon,pk"`,
+//  `db:"-"`, tag yoksa küçük harfli alan adı, gömülü struct'lar) bir
+//  struct'ın sütunlarını Blueprint'e çevirir. Pointer ve sql.Null* alanlar
+//  nullable'dır; tek tamsayı primary key otomatik artandır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Field, bir struct alanının sütun karşılığıdır.
+type Field struct {
+	Column string // Sütun adı
+	GoType string // Go tipi: "int64", "*string", "sql.NullTime", "time.Time", "[]byte"
+	PK     bool   // `db:"...,pk"`
+}
+
+// goColumnTypes, desteklenen Go tiplerinin sütun türleridir. null, tipin
+// NULL'u kendisi ifade ettiğini (sql.Null*) belirtir.
+var goColumnTypes = map[string]struct {
+	typ      ColumnType
+	unsigned bool
+	null     bool
+}{
+	"int64": {typ: TypeBigInteger}, "int": {typ: TypeBigInteger},
+	"uint64": {typ: TypeBigInteger, unsigned: true}, "uint": {typ: TypeBigInteger, unsigned: true},
+	"int32": {typ: TypeInteger}, "uint32": {typ: TypeInteger, unsigned: true},
+	"int16": {typ: TypeSmallInteger}, "uint16": {typ: TypeSmallInteger, unsigned: true},
+	"int8": {typ: TypeTinyInteger}, "uint8": {typ: TypeTinyInteger, unsigned: true},
+	"bool":    {typ: TypeBoolean},
+	"float32": {typ: TypeFloat}, "float64": {typ: TypeDouble},
+	"string":    {typ: TypeString},
+	"time.Time": {typ: TypeDateTime},
+	"[]byte":    {typ: TypeBinary}, "[]uint8": {typ: TypeBinary},
+	"sql.NullString":  {typ: TypeString, null: true},
+	"sql.NullInt64":   {typ: TypeBigInteger, null: true},
+	"sql.NullInt32":   {typ: TypeInteger, null: true},
+	"sql.NullInt16":   {typ: TypeSmallInteger, null: true},
+	"sql.NullByte":    {typ: TypeTinyInteger, unsigned: true, null: true},
+	"sql.NullBool":    {typ: TypeBoolean, null: true},
+	"sql.NullFloat64": {typ: TypeDouble, null: true},
+	"sql.NullTime":    {typ: TypeDateTime, null: true},
+}
+
+// FromFields, alan listesinden table için bir Blueprint oluşturur.
+// Desteklenmeyen Go tipleri fluentsql.ErrInvalidValue ile reddedilir.
+func FromFields(table, prefix string, fields []Field) (*Blueprint, error) {
+	bp := NewBlueprint(table, prefix)
+	bp.inferred = true
+
+	var pk []string
+	var pkDef *ColumnDefinition
+	for _, f := range fields {
+		goType := strings.TrimPrefix(f.GoType, "*")
+		t, ok := goColumnTypes[goType]
+		if !ok {
+			return nil, fluentsql.NewValidationError("value", f.GoType, "unsupported Go type for column "+f.Column)
+		}
+
+		def := bp.addColumn(f.Column, t.typ)
+		if t.typ == TypeString {
+			def.col.Length = 255
+		}
+		if t.unsigned {
+			def.Unsigned()
+		}
+		if t.null || goType != f.GoType {
+			def.Nullable()
+		}
+		if f.PK {
+			pk, pkDef = append(pk, f.Column), def
+		}
+	}
+
+	switch {
+	case len(pk) == 1:
+		pkDef.Primary()
+		if t := pkDef.col.Type; isNumeric(t) && t != TypeFloat && t != TypeDouble && !pkDef.col.Nullable {
+			pkDef.AutoIncrement()
+		}
+	case len(pk) > 1:
+		bp.Primary(pk...)
+	}
+	return bp, nil
+}
+
+// FromStruct, model struct'ından (veya pointer'ından) bir Blueprint
+// oluşturur. table boşsa modelin TableName() metodu kullanılır.
+//
+// Örnek:
+//
+//	bp, err := schema.FromStruct("", db.TablePrefix(), models.User{})
+func FromStruct(table, prefix string, model any) (*Blueprint, error) {
+	t := reflect.TypeOf(model)
+	if t == nil {
+		return nil, fluentsql.NewValidationError("value", "nil", "model must be a struct")
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fluentsql.NewValidationError("value", t.String(), "model must be a struct")
+	}
+	if table == "" {
+		n, ok := reflect.New(t).Interface().(interface{ TableName() string })
+		if !ok {
+			return nil, fluentsql.NewValidationError("value", t.String(), "table name is empty and the model has no TableName method")
+		}
+		table = n.TableName()
+	}
+	return FromFields(table, prefix, structFields(t, nil))
+}
+
+// structFields, DefaultScanner'ın kurallarıyla struct alanlarını toplar.
+func structFields(t reflect.Type, fields []Field) []Field {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			fields = structFields(sf.Type, fields)
+			continue
+		}
+		tag := sf.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+		f := Field{Column: strings.ToLower(sf.Name), GoType: sf.Type.String()}
+		if tag != "" {
+			parts := strings.Split(tag, ",")
+			f.Column = parts[0]
+			for _, p := range parts[1:] {
+				f.PK = f.PK || p == "pk"
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
@@ -0,0 +1,293 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/internal/validation"
+)
+
+// -----------------------------------------------------------------------------
+//  Şema Grammar'ı — Blueprint'ten DDL'e
+//
+//  Her veritabanı DDL'i farklı yazar: MySQL sütunları MODIFY ile değiştirir,
+//  PostgreSQL ALTER COLUMN ... TYPE kullanır, SQLite ise sütun değiştirmeyi
+//  hiç desteklemez. Grammar, Blueprint'teki komutları sırasıyla ilgili
+//  veritabanının ifadelerine çevirir. Veritabanının karşılığı olmayan bir
+//  komut ErrNotSupported ile reddedilir; hiçbir komut sessizce atlanmaz.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Grammar, Blueprint'leri belirli bir veritabanının DDL ifadelerine derler.
+type Grammar interface {
+	// Name, grammar'ın adını döndürür ("mysql", "postgres", "sqlite").
+	Name() string
+
+	// CompileCreate, CREATE TABLE ve ardından gelen index/yorum ifadelerini derler.
+	CompileCreate(bp *Blueprint) ([]string, error)
+
+	// CompileAlter, Blueprint komutlarını sırasıyla ALTER ifadelerine derler.
+	CompileAlter(bp *Blueprint) ([]string, error)
+
+	// CompileDrop, DROP TABLE ifadesini derler.
+	CompileDrop(table string, ifExists bool) (string, error)
+
+	// CompileRename, tabloyu yeniden adlandıran ifadeyi derler.
+	CompileRename(from, to string) (string, error)
+
+	// SupportsTransactionalDDL, DDL ifadelerinin transaction içinde geri
+	// alınabilir olup olmadığını bildirir. MySQL'de her DDL örtük commit yapar.
+	SupportsTransactionalDDL() bool
+}
+
+// quoter, bir veritabanının identifier tırnaklama kuralıdır.
+type quoter struct {
+	open, close string
+}
+
+// table, tablo adını doğrular ve tırnaklar. "schema.table" biçimi desteklenir.
+func (q quoter) table(name string) (string, error) {
+	if err := validateIdentifier(name); err != nil {
+		return "", err
+	}
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = q.open + p + q.close
+	}
+	return strings.Join(parts, "."), nil
+}
+
+// name, sütun, index veya constraint adını doğrular ve tırnaklar. Bu adlar
+// nitelikli olamaz; nokta içeren adlar reddedilir.
+func (q quoter) name(name string) (string, error) {
+	if err := validateIdentifier(name); err != nil {
+		return "", err
+	}
+	if strings.Contains(name, ".") {
+		return "", fluentsql.NewValidationError("identifier", name, "name cannot be qualified")
+	}
+	return q.open + name + q.close, nil
+}
+
+// columnize, sütun listesini "a", "b" biçiminde tırnaklar.
+func (q quoter) columnize(columns []string) (string, error) {
+	if len(columns) == 0 {
+		return "", fluentsql.NewValidationError("identifier", "", "column list cannot be empty")
+	}
+	wrapped := make([]string, len(columns))
+	for i, c := range columns {
+		w, err := q.name(c)
+		if err != nil {
+			return "", err
+		}
+		wrapped[i] = w
+	}
+	return strings.Join(wrapped, ", "), nil
+}
+
+// validateIdentifier, iç doğrulama hatasını fluentsql.ValidationError'a çevirir;
+// böylece errors.Is(err, fluentsql.ErrInvalidIdentifier) çalışır.
+func validateIdentifier(name string) error {
+	if err := validation.ValidateIdentifier(name); err != nil {
+		return fluentsql.NewValidationError("identifier", name, err.Error())
+	}
+	return nil
+}
+
+// literalOptions, DEFAULT değerlerinin veritabanına göre yazımıdır.
+type literalOptions struct {
+	trueLit, falseLit string
+	backslash         bool // Ters bölü de kaçırılsın mı (MySQL)
+}
+
+// quoteString, metni tek tırnaklı SQL literaline çevirir.
+func (o literalOptions) quoteString(s string) string {
+	if o.backslash {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// literal, DEFAULT değerini SQL literaline çevirir.
+func (o literalOptions) literal(v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "NULL", nil
+	case Expression:
+		return string(x), nil
+	case string:
+		return o.quoteString(x), nil
+	case bool:
+		if x {
+			return o.trueLit, nil
+		}
+		return o.falseLit, nil
+	case int:
+		return strconv.FormatInt(int64(x), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(x), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(x), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(x), 10), nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(x), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(x), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(x), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(x), 10), nil
+	case uint64:
+		return strconv.FormatUint(x, 10), nil
+	case float32:
+		return formatFloat(float64(x))
+	case float64:
+		return formatFloat(x)
+	default:
+		return "", fluentsql.NewValidationError("value", fmt.Sprintf("%T", v), "unsupported default value type")
+	}
+}
+
+func formatFloat(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fluentsql.NewValidationError("value", fmt.Sprint(f), "default must be a finite number")
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), nil
+}
+
+// enumValues, enum değerlerini literal listesine çevirir.
+func (o literalOptions) enumValues(values []string) (string, error) {
+	if len(values) == 0 {
+		return "", fluentsql.NewValidationError("value", "enum", "enum requires at least one value")
+	}
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = o.quoteString(v)
+	}
+	return strings.Join(quoted, ", "), nil
+}
+
+// optionRegex, ENGINE/CHARSET/COLLATE gibi tablo seçeneklerinin değerlerini doğrular.
+var optionRegex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// foreignActions, ON DELETE / ON UPDATE için izin verilen davranışlardır.
+var foreignActions = map[string]bool{
+	Cascade: true, SetNull: true, SetDefault: true, Restrict: true, NoAction: true,
+}
+
+// compileForeign, "CONSTRAINT name FOREIGN KEY (...) REFERENCES t (...)"
+// parçasını derler. Tüm grammar'lar aynı standart sözdizimini kullanır.
+func compileForeign(q quoter, fk ForeignKey) (string, error) {
+	name, err := q.name(fk.Name)
+	if err != nil {
+		return "", err
+	}
+	cols, err := q.columnize(fk.Columns)
+	if err != nil {
+		return "", err
+	}
+	if fk.On == "" {
+		return "", fluentsql.NewValidationError("identifier", fk.Name, "foreign key has no referenced table")
+	}
+	on, err := q.table(fk.On)
+	if err != nil {
+		return "", err
+	}
+	refs, err := q.columnize(fk.References)
+	if err != nil {
+		return "", err
+	}
+	sql := fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)", name, cols, on, refs)
+	for _, a := range []struct{ clause, action string }{{"ON DELETE", fk.OnDelete}, {"ON UPDATE", fk.OnUpdate}} {
+		if a.action == "" {
+			continue
+		}
+		action := strings.ToUpper(strings.TrimSpace(a.action))
+		if !foreignActions[action] {
+			return "", fluentsql.NewValidationError("value", a.action, "invalid foreign key action")
+		}
+		sql += " " + a.clause + " " + action
+	}
+	return sql, nil
+}
+
+// createIndex, standart "CREATE [UNIQUE] INDEX name ON table (cols)" ifadesini
+// derler (PostgreSQL ve SQLite).
+func createIndex(q quoter, table string, idx Index, ifNotExists bool) (string, error) {
+	name, err := q.name(idx.Name)
+	if err != nil {
+		return "", err
+	}
+	cols, err := q.columnize(idx.Columns)
+	if err != nil {
+		return "", err
+	}
+	kind := "INDEX"
+	if idx.Type == IndexUnique {
+		kind = "UNIQUE INDEX"
+	}
+	if ifNotExists {
+		kind += " IF NOT EXISTS"
+	}
+	return fmt.Sprintf("CREATE %s %s ON %s (%s)", kind, name, table, cols), nil
+}
+
+// primaryColumns, CREATE TABLE için primary key sütunlarını toplar: sütun
+// düzeyinde Primary işaretlenenler ve Primary(...) ile tanımlanan index.
+func primaryColumns(bp *Blueprint) []string {
+	var cols []string
+	for _, idx := range bp.Indexes() {
+		if idx.Type == IndexPrimary {
+			cols = append(cols, idx.Columns...)
+		}
+	}
+	return cols
+}
+
+// checkCreateCommands, CREATE TABLE'da anlamı olmayan komutları reddeder.
+func checkCreateCommands(bp *Blueprint) error {
+	for _, c := range bp.commands {
+		switch c.kind {
+		case cmdAddColumn:
+			if c.column.Change {
+				return fmt.Errorf("%w: Change() in CREATE TABLE", ErrNotSupported)
+			}
+		case cmdAddIndex, cmdAddForeign:
+		default:
+			return fmt.Errorf("%w: drop/rename commands in CREATE TABLE", ErrNotSupported)
+		}
+	}
+	return nil
+}
+
+// createTable, "CREATE TABLE [IF NOT EXISTS] " önekini döndürür.
+func createTable(bp *Blueprint, table string) string {
+	if bp.ifNotExists {
+		return "CREATE TABLE IF NOT EXISTS " + table
+	}
+	return "CREATE TABLE " + table
+}
+
+// notSupported, dialect'in desteklemediği komut için hata üretir.
+func notSupported(dialect, what string) error {
+	return fmt.Errorf("%w: %s does not support %s", ErrNotSupported, dialect, what)
+}
+
+// bareTable, "schema.table" biçimindeki adın tablo kısmını döndürür.
+func bareTable(table string) string {
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		return table[i+1:]
+	}
+	return table
+}
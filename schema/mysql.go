@@ -0,0 +1,355 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+//  MySQL / MariaDB Şema Grammar'ı
+//
+//  • Index'ler ve foreign key'ler CREATE TABLE içinde tanımlanır.
+//  • Sütun değişiklikleri MODIFY COLUMN ile yapılır; After() desteklenir.
+//  • ENGINE, CHARSET ve COLLATE tablo seçenekleri yalnızca burada geçerlidir.
+//  • DDL transaction içinde geri alınamaz (örtük commit).
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// MySQLGrammar, MySQL ve MariaDB için şema grammar'ıdır.
+type MySQLGrammar struct {
+	q   quoter
+	lit literalOptions
+}
+
+// MySQL, MySQL/MariaDB şema grammar'ını döndürür.
+func MySQL() *MySQLGrammar {
+	return &MySQLGrammar{
+		q:   quoter{"`", "`"},
+		lit: literalOptions{trueLit: "1", falseLit: "0", backslash: true},
+	}
+}
+
+// Name, grammar adını döndürür.
+func (g *MySQLGrammar) Name() string { return "mysql" }
+
+// SupportsTransactionalDDL, MySQL için false döner.
+func (g *MySQLGrammar) SupportsTransactionalDDL() bool { return false }
+
+// CompileCreate, CREATE TABLE ifadesini derler.
+func (g *MySQLGrammar) CompileCreate(bp *Blueprint) ([]string, error) {
+	if err := checkCreateCommands(bp); err != nil {
+		return nil, err
+	}
+	table, err := g.q.table(bp.Table())
+	if err != nil {
+		return nil, err
+	}
+
+	var defs []string
+	for _, col := range bp.Columns() {
+		def, err := g.column(col)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	if pk := primaryColumns(bp); len(pk) > 0 {
+		cols, err := g.q.columnize(pk)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, "PRIMARY KEY ("+cols+")")
+	}
+	for _, idx := range bp.Indexes() {
+		if idx.Type == IndexPrimary {
+			continue
+		}
+		def, err := g.indexDef(idx)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	for _, fk := range bp.ForeignKeys() {
+		def, err := compileForeign(g.q, fk)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+
+	sql := createTable(bp, table) + " (" + strings.Join(defs, ", ") + ")"
+	opts, err := g.tableOptions(bp)
+	if err != nil {
+		return nil, err
+	}
+	return []string{sql + opts}, nil
+}
+
+// CompileAlter, Blueprint komutlarını ALTER TABLE ifadelerine derler.
+func (g *MySQLGrammar) CompileAlter(bp *Blueprint) ([]string, error) {
+	table, err := g.q.table(bp.Table())
+	if err != nil {
+		return nil, err
+	}
+	prefix := "ALTER TABLE " + table + " "
+
+	var stmts []string
+	for _, c := range bp.commands {
+		var clauses []string
+		switch c.kind {
+		case cmdAddColumn:
+			def, err := g.column(*c.column)
+			if err != nil {
+				return nil, err
+			}
+			if c.column.Change {
+				clauses = append(clauses, "MODIFY COLUMN "+def)
+			} else {
+				clauses = append(clauses, "ADD COLUMN "+def)
+			}
+			for _, idx := range bp.columnIndexes(c.column) {
+				clause, err := g.addIndex(idx)
+				if err != nil {
+					return nil, err
+				}
+				clauses = append(clauses, clause)
+			}
+		case cmdAddIndex:
+			clause, err := g.addIndex(bp.resolveIndex(*c.index))
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, clause)
+		case cmdAddForeign:
+			def, err := compileForeign(g.q, bp.resolveForeign(*c.foreign))
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "ADD "+def)
+		case cmdDropColumn:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "DROP COLUMN "+name)
+		case cmdRenameColumn:
+			from, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			to, err := g.q.name(c.to)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "RENAME COLUMN "+from+" TO "+to)
+		case cmdDropIndex:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "DROP INDEX "+name)
+		case cmdDropPrimary:
+			clauses = append(clauses, "DROP PRIMARY KEY")
+		case cmdDropForeign:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, "DROP FOREIGN KEY "+name)
+		}
+		for _, clause := range clauses {
+			stmts = append(stmts, prefix+clause)
+		}
+	}
+	return stmts, nil
+}
+
+// CompileDrop, DROP TABLE ifadesini derler.
+func (g *MySQLGrammar) CompileDrop(table string, ifExists bool) (string, error) {
+	wrapped, err := g.q.table(table)
+	if err != nil {
+		return "", err
+	}
+	if ifExists {
+		return "DROP TABLE IF EXISTS " + wrapped, nil
+	}
+	return "DROP TABLE " + wrapped, nil
+}
+
+// CompileRename, "RENAME TABLE a TO b" ifadesini derler.
+func (g *MySQLGrammar) CompileRename(from, to string) (string, error) {
+	f, err := g.q.table(from)
+	if err != nil {
+		return "", err
+	}
+	t, err := g.q.table(to)
+	if err != nil {
+		return "", err
+	}
+	return "RENAME TABLE " + f + " TO " + t, nil
+}
+
+// column, tek bir sütun tanımını derler. Primary key CREATE TABLE'da ayrı
+// bir PRIMARY KEY tanımıyla eklenir.
+func (g *MySQLGrammar) column(col Column) (string, error) {
+	name, err := g.q.name(col.Name)
+	if err != nil {
+		return "", err
+	}
+	typ, err := g.typeFor(col)
+	if err != nil {
+		return "", err
+	}
+
+	sql := name + " " + typ
+	if col.Unsigned && isNumeric(col.Type) {
+		sql += " UNSIGNED"
+	}
+	if col.Nullable {
+		sql += " NULL"
+	} else {
+		sql += " NOT NULL"
+	}
+	switch {
+	case col.UseCurrent:
+		sql += " DEFAULT CURRENT_TIMESTAMP"
+	case col.HasDefault:
+		v, err := g.lit.literal(col.Default)
+		if err != nil {
+			return "", err
+		}
+		sql += " DEFAULT " + v
+	}
+	if col.UseCurrentOnUpdate {
+		sql += " ON UPDATE CURRENT_TIMESTAMP"
+	}
+	if col.AutoIncrement {
+		sql += " AUTO_INCREMENT"
+	}
+	if col.Comment != "" {
+		sql += " COMMENT " + g.lit.quoteString(col.Comment)
+	}
+	if col.After != "" {
+		after, err := g.q.name(col.After)
+		if err != nil {
+			return "", err
+		}
+		sql += " AFTER " + after
+	}
+	return sql, nil
+}
+
+// typeFor, sütun türünün MySQL karşılığını döndürür.
+func (g *MySQLGrammar) typeFor(col Column) (string, error) {
+	switch col.Type {
+	case TypeBigInteger:
+		return "BIGINT", nil
+	case TypeInteger:
+		return "INT", nil
+	case TypeSmallInteger:
+		return "SMALLINT", nil
+	case TypeTinyInteger:
+		return "TINYINT", nil
+	case TypeString:
+		return fmt.Sprintf("VARCHAR(%d)", col.Length), nil
+	case TypeChar:
+		return fmt.Sprintf("CHAR(%d)", col.Length), nil
+	case TypeText:
+		return "TEXT", nil
+	case TypeBoolean:
+		return "TINYINT(1)", nil
+	case TypeDecimal:
+		return fmt.Sprintf("DECIMAL(%d, %d)", col.Precision, col.Scale), nil
+	case TypeFloat:
+		return "FLOAT", nil
+	case TypeDouble:
+		return "DOUBLE", nil
+	case TypeDate:
+		return "DATE", nil
+	case TypeDateTime:
+		return "DATETIME", nil
+	case TypeTimestamp:
+		return "TIMESTAMP", nil
+	case TypeTime:
+		return "TIME", nil
+	case TypeJSON:
+		return "JSON", nil
+	case TypeBinary:
+		return "BLOB", nil
+	case TypeUUID:
+		return "CHAR(36)", nil
+	case TypeEnum:
+		values, err := g.lit.enumValues(col.Values)
+		if err != nil {
+			return "", err
+		}
+		return "ENUM(" + values + ")", nil
+	default:
+		return "", notSupported("mysql", "column type "+string(col.Type))
+	}
+}
+
+// indexDef, CREATE TABLE içindeki "UNIQUE KEY name (cols)" tanımını derler.
+func (g *MySQLGrammar) indexDef(idx Index) (string, error) {
+	name, err := g.q.name(idx.Name)
+	if err != nil {
+		return "", err
+	}
+	cols, err := g.q.columnize(idx.Columns)
+	if err != nil {
+		return "", err
+	}
+	if idx.Type == IndexUnique {
+		return "UNIQUE KEY " + name + " (" + cols + ")", nil
+	}
+	return "KEY " + name + " (" + cols + ")", nil
+}
+
+// addIndex, ALTER TABLE için "ADD UNIQUE/INDEX/PRIMARY KEY" parçasını derler.
+func (g *MySQLGrammar) addIndex(idx Index) (string, error) {
+	if idx.Type == IndexPrimary {
+		cols, err := g.q.columnize(idx.Columns)
+		if err != nil {
+			return "", err
+		}
+		return "ADD PRIMARY KEY (" + cols + ")", nil
+	}
+	def, err := g.indexDef(idx)
+	if err != nil {
+		return "", err
+	}
+	return "ADD " + def, nil
+}
+
+// tableOptions, ENGINE/CHARSET/COLLATE seçeneklerini derler.
+func (g *MySQLGrammar) tableOptions(bp *Blueprint) (string, error) {
+	var sql string
+	for _, o := range []struct{ key, value string }{
+		{"ENGINE", bp.engine},
+		{"DEFAULT CHARSET", bp.charset},
+		{"COLLATE", bp.collation},
+	} {
+		if o.value == "" {
+			continue
+		}
+		if !optionRegex.MatchString(o.value) {
+			return "", notSupported("mysql", "table option value "+o.value)
+		}
+		sql += " " + o.key + "=" + o.value
+	}
+	return sql, nil
+}
+
+// isNumeric, UNSIGNED uygulanabilen türleri belirler.
+func isNumeric(t ColumnType) bool {
+	switch t {
+	case TypeBigInteger, TypeInteger, TypeSmallInteger, TypeTinyInteger, TypeDecimal, TypeFloat, TypeDouble:
+		return true
+	}
+	return false
+}
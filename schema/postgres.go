@@ -0,0 +1,360 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+//  PostgreSQL Şema Grammar'ı
+//
+//  • Otomatik artan sütunlar SERIAL/BIGSERIAL olarak oluşturulur.
+//  • Index'ler CREATE TABLE'dan sonra ayrı CREATE INDEX ifadeleriyle,
+//    sütun yorumları COMMENT ON COLUMN ile eklenir.
+//  • Sütun değişiklikleri ALTER COLUMN ... TYPE / SET NOT NULL / SET DEFAULT
+//    parçalarından oluşan tek bir ALTER TABLE ifadesine derlenir.
+//  • UNSIGNED, After() ve tablo seçenekleri PostgreSQL'de karşılığı olmadığı
+//    için yok sayılır; bunlar veri modelini değiştirmeyen ipuçlarıdır.
+//  • DDL transaction içinde geri alınabilir.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// PostgresGrammar, PostgreSQL için şema grammar'ıdır.
+type PostgresGrammar struct {
+	q   quoter
+	lit literalOptions
+}
+
+// Postgres, PostgreSQL şema grammar'ını döndürür.
+func Postgres() *PostgresGrammar {
+	return &PostgresGrammar{
+		q:   quoter{`"`, `"`},
+		lit: literalOptions{trueLit: "TRUE", falseLit: "FALSE"},
+	}
+}
+
+// Name, grammar adını döndürür.
+func (g *PostgresGrammar) Name() string { return "postgres" }
+
+// SupportsTransactionalDDL, PostgreSQL için true döner.
+func (g *PostgresGrammar) SupportsTransactionalDDL() bool { return true }
+
+// CompileCreate, CREATE TABLE ve ardından gelen CREATE INDEX / COMMENT ON
+// ifadelerini derler.
+func (g *PostgresGrammar) CompileCreate(bp *Blueprint) ([]string, error) {
+	if err := checkCreateCommands(bp); err != nil {
+		return nil, err
+	}
+	table, err := g.q.table(bp.Table())
+	if err != nil {
+		return nil, err
+	}
+
+	var defs, after []string
+	for _, col := range bp.Columns() {
+		def, err := g.column(col)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+		if col.Comment != "" {
+			stmt, err := g.comment(table, col)
+			if err != nil {
+				return nil, err
+			}
+			after = append(after, stmt)
+		}
+	}
+	if pk := primaryColumns(bp); len(pk) > 0 {
+		cols, err := g.q.columnize(pk)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, "PRIMARY KEY ("+cols+")")
+	}
+	for _, fk := range bp.ForeignKeys() {
+		def, err := compileForeign(g.q, fk)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	for _, idx := range bp.Indexes() {
+		if idx.Type == IndexPrimary {
+			continue
+		}
+		stmt, err := createIndex(g.q, table, idx, bp.ifNotExists)
+		if err != nil {
+			return nil, err
+		}
+		after = append(after, stmt)
+	}
+
+	create := createTable(bp, table) + " (" + strings.Join(defs, ", ") + ")"
+	return append([]string{create}, after...), nil
+}
+
+// CompileAlter, Blueprint komutlarını sırasıyla ALTER ifadelerine derler.
+func (g *PostgresGrammar) CompileAlter(bp *Blueprint) ([]string, error) {
+	table, err := g.q.table(bp.Table())
+	if err != nil {
+		return nil, err
+	}
+	prefix := "ALTER TABLE " + table + " "
+
+	var stmts []string
+	for _, c := range bp.commands {
+		switch c.kind {
+		case cmdAddColumn:
+			var stmt string
+			if c.column.Change {
+				stmt, err = g.change(prefix, *c.column)
+			} else {
+				var def string
+				def, err = g.column(*c.column)
+				stmt = prefix + "ADD COLUMN " + def
+			}
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, stmt)
+			if c.column.Comment != "" {
+				stmt, err := g.comment(table, *c.column)
+				if err != nil {
+					return nil, err
+				}
+				stmts = append(stmts, stmt)
+			}
+			for _, idx := range bp.columnIndexes(c.column) {
+				stmt, err := g.addIndex(table, idx)
+				if err != nil {
+					return nil, err
+				}
+				stmts = append(stmts, stmt)
+			}
+		case cmdAddIndex:
+			stmt, err := g.addIndex(table, bp.resolveIndex(*c.index))
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, stmt)
+		case cmdAddForeign:
+			def, err := compileForeign(g.q, bp.resolveForeign(*c.foreign))
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"ADD "+def)
+		case cmdDropColumn:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"DROP COLUMN "+name)
+		case cmdRenameColumn:
+			from, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			to, err := g.q.name(c.to)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"RENAME COLUMN "+from+" TO "+to)
+		case cmdDropIndex:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, "DROP INDEX "+name)
+		case cmdDropPrimary:
+			// PostgreSQL, adı verilmeyen primary key'i "{tablo}_pkey" olarak adlandırır.
+			name, err := g.q.name(bareTable(bp.Table()) + "_pkey")
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"DROP CONSTRAINT "+name)
+		case cmdDropForeign:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"DROP CONSTRAINT "+name)
+		}
+	}
+	return stmts, nil
+}
+
+// CompileDrop, DROP TABLE ifadesini derler.
+func (g *PostgresGrammar) CompileDrop(table string, ifExists bool) (string, error) {
+	wrapped, err := g.q.table(table)
+	if err != nil {
+		return "", err
+	}
+	if ifExists {
+		return "DROP TABLE IF EXISTS " + wrapped, nil
+	}
+	return "DROP TABLE " + wrapped, nil
+}
+
+// CompileRename, "ALTER TABLE a RENAME TO b" ifadesini derler. Hedef ad
+// şema içeremez; tablo aynı şemada kalır.
+func (g *PostgresGrammar) CompileRename(from, to string) (string, error) {
+	f, err := g.q.table(from)
+	if err != nil {
+		return "", err
+	}
+	t, err := g.q.name(bareTable(to))
+	if err != nil {
+		return "", err
+	}
+	return "ALTER TABLE " + f + " RENAME TO " + t, nil
+}
+
+// column, tek bir sütun tanımını derler.
+func (g *PostgresGrammar) column(col Column) (string, error) {
+	name, err := g.q.name(col.Name)
+	if err != nil {
+		return "", err
+	}
+	typ, err := g.typeFor(col, col.AutoIncrement)
+	if err != nil {
+		return "", err
+	}
+
+	sql := name + " " + typ
+	if !col.Nullable {
+		sql += " NOT NULL"
+	}
+	def, err := g.defaultValue(col)
+	if err != nil {
+		return "", err
+	}
+	if def != "" {
+		sql += " DEFAULT " + def
+	}
+	if col.Type == TypeEnum {
+		values, err := g.lit.enumValues(col.Values)
+		if err != nil {
+			return "", err
+		}
+		sql += " CHECK (" + name + " IN (" + values + "))"
+	}
+	return sql, nil
+}
+
+// change, mevcut sütunu tanıma göre değiştiren ALTER TABLE ifadesini derler.
+func (g *PostgresGrammar) change(prefix string, col Column) (string, error) {
+	name, err := g.q.name(col.Name)
+	if err != nil {
+		return "", err
+	}
+	typ, err := g.typeFor(col, false)
+	if err != nil {
+		return "", err
+	}
+	alter := "ALTER COLUMN " + name
+	parts := []string{alter + " TYPE " + typ}
+	if col.Nullable {
+		parts = append(parts, alter+" DROP NOT NULL")
+	} else {
+		parts = append(parts, alter+" SET NOT NULL")
+	}
+	def, err := g.defaultValue(col)
+	if err != nil {
+		return "", err
+	}
+	if def != "" {
+		parts = append(parts, alter+" SET DEFAULT "+def)
+	} else {
+		parts = append(parts, alter+" DROP DEFAULT")
+	}
+	return prefix + strings.Join(parts, ", "), nil
+}
+
+// defaultValue, sütunun DEFAULT ifadesini döndürür; yoksa boş döner.
+func (g *PostgresGrammar) defaultValue(col Column) (string, error) {
+	switch {
+	case col.UseCurrent:
+		return "CURRENT_TIMESTAMP", nil
+	case col.HasDefault:
+		return g.lit.literal(col.Default)
+	}
+	return "", nil
+}
+
+// comment, "COMMENT ON COLUMN t.c IS '...'" ifadesini derler.
+func (g *PostgresGrammar) comment(table string, col Column) (string, error) {
+	name, err := g.q.name(col.Name)
+	if err != nil {
+		return "", err
+	}
+	return "COMMENT ON COLUMN " + table + "." + name + " IS " + g.lit.quoteString(col.Comment), nil
+}
+
+// addIndex, ALTER sırasında index ekleyen ifadeyi derler.
+func (g *PostgresGrammar) addIndex(table string, idx Index) (string, error) {
+	if idx.Type == IndexPrimary {
+		cols, err := g.q.columnize(idx.Columns)
+		if err != nil {
+			return "", err
+		}
+		return "ALTER TABLE " + table + " ADD PRIMARY KEY (" + cols + ")", nil
+	}
+	return createIndex(g.q, table, idx, false)
+}
+
+// typeFor, sütun türünün PostgreSQL karşılığını döndürür. serial true ise
+// tamsayı türleri SERIAL ailesine çevrilir.
+func (g *PostgresGrammar) typeFor(col Column, serial bool) (string, error) {
+	switch col.Type {
+	case TypeBigInteger:
+		if serial {
+			return "BIGSERIAL", nil
+		}
+		return "BIGINT", nil
+	case TypeInteger:
+		if serial {
+			return "SERIAL", nil
+		}
+		return "INTEGER", nil
+	case TypeSmallInteger, TypeTinyInteger:
+		if serial {
+			return "SMALLSERIAL", nil
+		}
+		return "SMALLINT", nil
+	case TypeString:
+		return fmt.Sprintf("VARCHAR(%d)", col.Length), nil
+	case TypeChar:
+		return fmt.Sprintf("CHAR(%d)", col.Length), nil
+	case TypeText:
+		return "TEXT", nil
+	case TypeBoolean:
+		return "BOOLEAN", nil
+	case TypeDecimal:
+		return fmt.Sprintf("NUMERIC(%d, %d)", col.Precision, col.Scale), nil
+	case TypeFloat:
+		return "REAL", nil
+	case TypeDouble:
+		return "DOUBLE PRECISION", nil
+	case TypeDate:
+		return "DATE", nil
+	case TypeDateTime, TypeTimestamp:
+		return "TIMESTAMP", nil
+	case TypeTime:
+		return "TIME", nil
+	case TypeJSON:
+		return "JSONB", nil
+	case TypeBinary:
+		return "BYTEA", nil
+	case TypeUUID:
+		return "UUID", nil
+	case TypeEnum:
+		return "VARCHAR(255)", nil
+	default:
+		return "", notSupported("postgres", "column type "+string(col.Type))
+	}
+}
@@ -0,0 +1,265 @@
+// Package schema, fluentsql için veritabanından bağımsız DDL (CREATE/ALTER/DROP
+// TABLE) oluşturucusudur.
+//
+// Tablo tanımları bir Blueprint üzerine yazılır; Grammar implementasyonları
+// (MySQL, PostgreSQL, SQLite) bu tanımı ilgili veritabanının SQL'ine çevirir.
+// Böylece aynı migration kodu her veritabanında çalışır:
+//
+//	s := schema.New(db)
+//
+//	err := s.Create(ctx, "users", func(t *schema.Blueprint) {
+//	    t.ID()
+//	    t.String("email", 255).Unique()
+//	    t.Timestamps()
+//	})
+//
+//	err = s.Table(ctx, "users", func(t *schema.Blueprint) {
+//	    t.String("phone", 32).Nullable().After("email")
+//	    t.DropColumn("legacy_flag")
+//	})
+//
+// Tüm tablo, sütun, index ve constraint adları doğrulanır ve tırnaklanır;
+// geçersiz adlar fluentsql.ErrInvalidIdentifier ile reddedilir.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+// Sentinel errors for the schema package.
+var (
+	// ErrNotSupported is returned when the dialect cannot express a blueprint command
+	// (e.g. changing a column type on SQLite).
+	ErrNotSupported = errors.New("fluentsql: schema operation not supported by dialect")
+
+	// ErrUnsupportedDialect is returned when no schema grammar exists for a driver name.
+	// It is the same value as fluentsql.ErrUnsupportedDialect.
+	ErrUnsupportedDialect = fluentsql.ErrUnsupportedDialect
+)
+
+// GrammarFor, sürücü veya dialect adına göre şema grammar'ını döndürür.
+// Tanınan adlar: mysql, mariadb, postgres, postgresql, pgx, sqlite, sqlite3.
+func GrammarFor(name string) (Grammar, error) {
+	switch strings.ToLower(name) {
+	case "mysql", "mariadb":
+		return MySQL(), nil
+	case "postgres", "postgresql", "pgx":
+		return Postgres(), nil
+	case "sqlite", "sqlite3":
+		return SQLite(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedDialect, name)
+	}
+}
+
+// -----------------------------------------------------------------------------
+//  Builder — Blueprint'leri Derleyip Çalıştıran Katman
+//
+//  Builder, bir QueryExecutor (DB, Transaction veya *sql.DB) ile bir şema
+//  Grammar'ını bir araya getirir. Her işlem önce tüm SQL ifadelerini derler,
+//  ardından sırayla çalıştırır; derleme hatasında hiçbir ifade gönderilmez.
+//  DB ve Transaction üzerinde ifadeler ExecStatement ile çalışır; böylece
+//  interceptor'lar, logger, yavaş sorgu raporu, tracing ve metrikler DDL'i de
+//  görür. Tablo prefix'i sorgu builder'ıyla aynı kurallarla
+//  (fluentsql.PrefixTable) uygulanır ve WithUnprefixedTables'a uyar.
+//
+//  MySQL'de DDL örtük commit yaptığından çok adımlı bir işlem yarıda kalırsa
+//  geri alınamaz. PostgreSQL ve SQLite'ta Using(tx) ile tüm adımlar tek
+//  transaction'da çalıştırılabilir (bkz. Grammar.SupportsTransactionalDDL).
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// Builder, şema işlemlerini derleyip çalıştırır.
+type Builder struct {
+	exec       fluentsql.QueryExecutor
+	grammar    Grammar
+	prefix     string
+	unprefixed []string // Prefix uygulanmayacak paylaşımlı tablolar
+	err        error    // Ertelenmiş yapılandırma hatası (ör. bilinmeyen grammar)
+}
+
+// statementExecutor, ifadeleri interceptor zincirinden geçirerek çalıştıran
+// executor'lardır (fluentsql.DB ve fluentsql.Transaction).
+type statementExecutor interface {
+	ExecStatement(ctx context.Context, op, table, query string, args ...any) (sql.Result, error)
+}
+
+// New, DB'nin grammar'ına, tablo prefix'ine ve prefix'siz tablolarına göre
+// bir Builder oluşturur. DB'nin grammar'ı için şema grammar'ı yoksa hata ilk
+// işlemde döner.
+func New(db *fluentsql.DB) *Builder {
+	b := &Builder{exec: db, prefix: db.TablePrefix(), unprefixed: db.UnprefixedTables()}
+	b.grammar, b.err = GrammarFor(db.Grammar().Name())
+	return b
+}
+
+// NewBuilder, verilen executor ve grammar ile bir Builder oluşturur.
+//
+// Örnek:
+//
+//	s := schema.NewBuilder(sqlDB, schema.Postgres())
+func NewBuilder(exec fluentsql.QueryExecutor, grammar Grammar) *Builder {
+	return &Builder{exec: exec, grammar: grammar}
+}
+
+// WithPrefix, tablo adlarına prefix ekleyen bir kopya döndürür.
+func (b *Builder) WithPrefix(prefix string) *Builder {
+	c := *b
+	c.prefix = prefix
+	return &c
+}
+
+// WithUnprefixedTables, prefix uygulanmayacak tabloları ekleyen bir kopya
+// döndürür (bkz. fluentsql.WithUnprefixedTables).
+func (b *Builder) WithUnprefixedTables(tables ...string) *Builder {
+	c := *b
+	c.unprefixed = append(append([]string(nil), b.unprefixed...), tables...)
+	return &c
+}
+
+// Using, ifadeleri verilen executor üzerinde (ör. bir Transaction) çalıştıran
+// bir kopya döndürür.
+func (b *Builder) Using(exec fluentsql.QueryExecutor) *Builder {
+	c := *b
+	c.exec = exec
+	return &c
+}
+
+// Grammar, Builder'ın kullandığı şema grammar'ını döndürür.
+func (b *Builder) Grammar() Grammar {
+	return b.grammar
+}
+
+// Blueprint, Builder'ın prefix'i ve prefix'siz tablolarıyla yeni bir
+// Blueprint oluşturur.
+func (b *Builder) Blueprint(table string) *Blueprint {
+	bp := NewBlueprint(table, b.prefix)
+	bp.unprefixed = b.unprefixed
+	return bp
+}
+
+// CreateSQL, CREATE TABLE işleminin ifadelerini çalıştırmadan döndürür.
+func (b *Builder) CreateSQL(table string, fn func(*Blueprint)) ([]string, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	bp := b.Blueprint(table)
+	fn(bp)
+	return b.grammar.CompileCreate(bp)
+}
+
+// CreateIfNotExistsSQL, CREATE TABLE IF NOT EXISTS işleminin ifadelerini
+// çalıştırmadan döndürür. PostgreSQL ve SQLite'ta index'ler de
+// IF NOT EXISTS ile oluşturulur.
+func (b *Builder) CreateIfNotExistsSQL(table string, fn func(*Blueprint)) ([]string, error) {
+	return b.CreateSQL(table, func(bp *Blueprint) {
+		bp.ifNotExists = true
+		fn(bp)
+	})
+}
+
+// TableSQL, ALTER TABLE işleminin ifadelerini çalıştırmadan döndürür.
+func (b *Builder) TableSQL(table string, fn func(*Blueprint)) ([]string, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	bp := b.Blueprint(table)
+	fn(bp)
+	return b.grammar.CompileAlter(bp)
+}
+
+// Create, fn ile tanımlanan tabloyu oluşturur.
+func (b *Builder) Create(ctx context.Context, table string, fn func(*Blueprint)) error {
+	stmts, err := b.CreateSQL(table, fn)
+	if err != nil {
+		return fluentsql.NewQueryError("create_table", table, "", err)
+	}
+	return b.run(ctx, "create_table", table, stmts)
+}
+
+// CreateIfNotExists, tablo yoksa fn ile tanımlanan tabloyu oluşturur.
+func (b *Builder) CreateIfNotExists(ctx context.Context, table string, fn func(*Blueprint)) error {
+	stmts, err := b.CreateIfNotExistsSQL(table, fn)
+	if err != nil {
+		return fluentsql.NewQueryError("create_table", table, "", err)
+	}
+	return b.run(ctx, "create_table", table, stmts)
+}
+
+// Table, mevcut tabloyu fn ile tanımlanan adımlarla değiştirir.
+func (b *Builder) Table(ctx context.Context, table string, fn func(*Blueprint)) error {
+	stmts, err := b.TableSQL(table, fn)
+	if err != nil {
+		return fluentsql.NewQueryError("alter_table", table, "", err)
+	}
+	return b.run(ctx, "alter_table", table, stmts)
+}
+
+// Drop, tabloyu siler.
+func (b *Builder) Drop(ctx context.Context, table string) error {
+	return b.drop(ctx, table, false)
+}
+
+// DropIfExists, tablo varsa siler.
+func (b *Builder) DropIfExists(ctx context.Context, table string) error {
+	return b.drop(ctx, table, true)
+}
+
+func (b *Builder) drop(ctx context.Context, table string, ifExists bool) error {
+	if b.err != nil {
+		return fluentsql.NewQueryError("drop_table", table, "", b.err)
+	}
+	stmt, err := b.grammar.CompileDrop(b.Blueprint(table).Table(), ifExists)
+	if err != nil {
+		return fluentsql.NewQueryError("drop_table", table, "", err)
+	}
+	return b.run(ctx, "drop_table", table, []string{stmt})
+}
+
+// Rename, tabloyu yeniden adlandırır.
+func (b *Builder) Rename(ctx context.Context, from, to string) error {
+	if b.err != nil {
+		return fluentsql.NewQueryError("rename_table", from, "", b.err)
+	}
+	stmt, err := b.grammar.CompileRename(b.Blueprint(from).Table(), b.Blueprint(to).Table())
+	if err != nil {
+		return fluentsql.NewQueryError("rename_table", from, "", err)
+	}
+	return b.run(ctx, "rename_table", from, []string{stmt})
+}
+
+// run, derlenmiş ifadeleri sırayla çalıştırır ve ilk hatada durur.
+// DB ve Transaction'da ifadeler interceptor zincirinden geçer; *sql.DB gibi
+// yalın executor'larda doğrudan ExecContext çağrılır.
+func (b *Builder) run(ctx context.Context, op, table string, stmts []string) error {
+	if b.exec == nil {
+		return fluentsql.NewQueryError(op, table, "", fluentsql.ErrNoExecutor)
+	}
+	for _, stmt := range stmts {
+		var err error
+		if se, ok := b.exec.(statementExecutor); ok {
+			_, err = se.ExecStatement(ctx, op, table, stmt)
+		} else {
+			_, err = b.exec.ExecContext(ctx, stmt)
+		}
+		if err != nil {
+			return fluentsql.NewQueryError(op, table, stmt, err)
+		}
+	}
+	return nil
+}
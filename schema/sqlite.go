@@ -0,0 +1,270 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+//  SQLite Şema Grammar'ı
+//
+//  • Tek sütunlu otomatik artan primary key, SQLite'ın rowid takma adı olan
+//    "INTEGER PRIMARY KEY AUTOINCREMENT" biçiminde yazılır.
+//  • Foreign key'ler yalnızca CREATE TABLE içinde tanımlanabilir.
+//  • ALTER TABLE yalnızca sütun ekleme, silme (3.35+) ve yeniden adlandırmayı
+//    (3.25+) destekler. Sütun değiştirme, primary/foreign key ekleme veya
+//    silme tabloyu yeniden oluşturmayı gerektirir ve ErrNotSupported döner.
+//  • Sütun yorumları ve UNSIGNED yok sayılır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// SQLiteGrammar, SQLite için şema grammar'ıdır.
+type SQLiteGrammar struct {
+	q   quoter
+	lit literalOptions
+}
+
+// SQLite, SQLite şema grammar'ını döndürür.
+func SQLite() *SQLiteGrammar {
+	return &SQLiteGrammar{
+		q:   quoter{`"`, `"`},
+		lit: literalOptions{trueLit: "1", falseLit: "0"},
+	}
+}
+
+// Name, grammar adını döndürür.
+func (g *SQLiteGrammar) Name() string { return "sqlite" }
+
+// SupportsTransactionalDDL, SQLite için true döner.
+func (g *SQLiteGrammar) SupportsTransactionalDDL() bool { return true }
+
+// CompileCreate, CREATE TABLE ve ardından gelen CREATE INDEX ifadelerini derler.
+func (g *SQLiteGrammar) CompileCreate(bp *Blueprint) ([]string, error) {
+	if err := checkCreateCommands(bp); err != nil {
+		return nil, err
+	}
+	table, err := g.q.table(bp.Table())
+	if err != nil {
+		return nil, err
+	}
+
+	pk := primaryColumns(bp)
+	var rowid string // INTEGER PRIMARY KEY AUTOINCREMENT olarak yazılan sütun
+	if len(pk) == 1 {
+		for _, col := range bp.Columns() {
+			if col.Name == pk[0] && col.AutoIncrement {
+				rowid = col.Name
+			}
+		}
+	}
+
+	var defs []string
+	for _, col := range bp.Columns() {
+		if col.Name == rowid {
+			name, err := g.q.name(col.Name)
+			if err != nil {
+				return nil, err
+			}
+			defs = append(defs, name+" INTEGER PRIMARY KEY AUTOINCREMENT")
+			continue
+		}
+		def, err := g.column(col)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	if len(pk) > 0 && rowid == "" {
+		cols, err := g.q.columnize(pk)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, "PRIMARY KEY ("+cols+")")
+	}
+	for _, fk := range bp.ForeignKeys() {
+		def, err := compileForeign(g.q, fk)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+
+	stmts := []string{createTable(bp, table) + " (" + strings.Join(defs, ", ") + ")"}
+	for _, idx := range bp.Indexes() {
+		if idx.Type == IndexPrimary {
+			continue
+		}
+		stmt, err := createIndex(g.q, table, idx, bp.ifNotExists)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, nil
+}
+
+// CompileAlter, Blueprint komutlarını sırasıyla ALTER ifadelerine derler.
+func (g *SQLiteGrammar) CompileAlter(bp *Blueprint) ([]string, error) {
+	table, err := g.q.table(bp.Table())
+	if err != nil {
+		return nil, err
+	}
+	prefix := "ALTER TABLE " + table + " "
+
+	var stmts []string
+	for _, c := range bp.commands {
+		switch c.kind {
+		case cmdAddColumn:
+			if c.column.Change {
+				return nil, notSupported("sqlite", "changing columns")
+			}
+			if c.column.Primary || c.column.AutoIncrement {
+				return nil, notSupported("sqlite", "adding primary key columns")
+			}
+			def, err := g.column(*c.column)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"ADD COLUMN "+def)
+			for _, idx := range bp.columnIndexes(c.column) {
+				stmt, err := createIndex(g.q, table, idx, false)
+				if err != nil {
+					return nil, err
+				}
+				stmts = append(stmts, stmt)
+			}
+		case cmdAddIndex:
+			if c.index.Type == IndexPrimary {
+				return nil, notSupported("sqlite", "adding a primary key")
+			}
+			stmt, err := createIndex(g.q, table, bp.resolveIndex(*c.index), false)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, stmt)
+		case cmdDropColumn:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"DROP COLUMN "+name)
+		case cmdRenameColumn:
+			from, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			to, err := g.q.name(c.to)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, prefix+"RENAME COLUMN "+from+" TO "+to)
+		case cmdDropIndex:
+			name, err := g.q.name(c.name)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, "DROP INDEX "+name)
+		case cmdAddForeign, cmdDropForeign:
+			return nil, notSupported("sqlite", "altering foreign keys")
+		case cmdDropPrimary:
+			return nil, notSupported("sqlite", "dropping a primary key")
+		}
+	}
+	return stmts, nil
+}
+
+// CompileDrop, DROP TABLE ifadesini derler.
+func (g *SQLiteGrammar) CompileDrop(table string, ifExists bool) (string, error) {
+	wrapped, err := g.q.table(table)
+	if err != nil {
+		return "", err
+	}
+	if ifExists {
+		return "DROP TABLE IF EXISTS " + wrapped, nil
+	}
+	return "DROP TABLE " + wrapped, nil
+}
+
+// CompileRename, "ALTER TABLE a RENAME TO b" ifadesini derler.
+func (g *SQLiteGrammar) CompileRename(from, to string) (string, error) {
+	f, err := g.q.table(from)
+	if err != nil {
+		return "", err
+	}
+	t, err := g.q.name(bareTable(to))
+	if err != nil {
+		return "", err
+	}
+	return "ALTER TABLE " + f + " RENAME TO " + t, nil
+}
+
+// column, tek bir sütun tanımını derler.
+func (g *SQLiteGrammar) column(col Column) (string, error) {
+	name, err := g.q.name(col.Name)
+	if err != nil {
+		return "", err
+	}
+	typ, err := g.typeFor(col)
+	if err != nil {
+		return "", err
+	}
+
+	sql := name + " " + typ
+	if !col.Nullable {
+		sql += " NOT NULL"
+	}
+	switch {
+	case col.UseCurrent:
+		sql += " DEFAULT CURRENT_TIMESTAMP"
+	case col.HasDefault:
+		v, err := g.lit.literal(col.Default)
+		if err != nil {
+			return "", err
+		}
+		sql += " DEFAULT " + v
+	}
+	if col.Type == TypeEnum {
+		values, err := g.lit.enumValues(col.Values)
+		if err != nil {
+			return "", err
+		}
+		sql += " CHECK (" + name + " IN (" + values + "))"
+	}
+	return sql, nil
+}
+
+// typeFor, sütun türünün SQLite karşılığını döndürür.
+func (g *SQLiteGrammar) typeFor(col Column) (string, error) {
+	switch col.Type {
+	case TypeBigInteger, TypeInteger, TypeSmallInteger, TypeTinyInteger, TypeBoolean:
+		return "INTEGER", nil
+	case TypeString:
+		return fmt.Sprintf("VARCHAR(%d)", col.Length), nil
+	case TypeChar:
+		return fmt.Sprintf("CHAR(%d)", col.Length), nil
+	case TypeText, TypeJSON:
+		return "TEXT", nil
+	case TypeDecimal:
+		return fmt.Sprintf("NUMERIC(%d, %d)", col.Precision, col.Scale), nil
+	case TypeFloat, TypeDouble:
+		return "REAL", nil
+	case TypeDate:
+		return "DATE", nil
+	case TypeDateTime, TypeTimestamp:
+		return "DATETIME", nil
+	case TypeTime:
+		return "TIME", nil
+	case TypeBinary:
+		return "BLOB", nil
+	case TypeUUID:
+		return "VARCHAR(36)", nil
+	case TypeEnum:
+		return "VARCHAR(255)", nil
+	default:
+		return "", notSupported("sqlite", "column type "+string(col.Type))
+	}
+}
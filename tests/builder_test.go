@@ -0,0 +1,447 @@
+package tests
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+func TestBuilder_WhereFullText(t *testing.T) {
+	columns := []string{"name", "description"}
+
+	qb := fluentsql.Table("products").
+		Select("id", "name").
+		WhereFullText(columns, "+wireless -headphones").
+		OrWhereFullText([]string{"tags"}, "audio", dialect.FullTextOptions{Mode: dialect.FullTextNatural}).
+		OrderByRelevance(columns, "+wireless -headphones").
+		Limit(20)
+
+	// Çağıranın slice'ı sonradan değiştirmesi derlenen sorguyu etkilememeli
+	columns[0] = "name; DROP TABLE products"
+
+	gotSQL, gotArgs, err := qb.ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL() error = %v", err)
+	}
+
+	wantSQL := "SELECT `id`, `name` FROM `products` " +
+		"WHERE MATCH(`name`, `description`) AGAINST(? IN BOOLEAN MODE) " +
+		"OR MATCH(`tags`) AGAINST(? IN NATURAL LANGUAGE MODE) " +
+		"ORDER BY MATCH(`name`, `description`) AGAINST(? IN BOOLEAN MODE) DESC LIMIT 20"
+	if gotSQL != wantSQL {
+		t.Errorf("ToSQL() SQL = %q, want %q", gotSQL, wantSQL)
+	}
+
+	wantArgs := []any{"+wireless -headphones", "audio", "+wireless -headphones"}
+	if !reflect.DeepEqual(gotArgs, wantArgs) {
+		t.Errorf("ToSQL() args = %v, want %v", gotArgs, wantArgs)
+	}
+}
+
+func TestBuilder_LockRequiresTransaction(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	type job struct {
+		ID int64 `db:"id"`
+	}
+
+	var jobs []job
+	err := db.Table("jobs").LockForUpdate().SkipLocked().GetContext(ctx, &jobs)
+	if !errors.Is(err, fluentsql.ErrLockOutsideTransaction) {
+		t.Fatalf("GetContext() outside transaction error = %v, want ErrLockOutsideTransaction", err)
+	}
+	if len(fake.Queries()) != 0 {
+		t.Fatalf("no SQL should reach the driver, got %v", fake.SQL())
+	}
+
+	fake.OnQuery(func(query string, args []any) fakeResponse {
+		return fakeResponse{Columns: []string{"id"}}
+	})
+	err = db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		return tx.Table("jobs").
+			Where("status", "=", "pending").
+			Limit(5).
+			LockForUpdate().
+			SkipLocked().
+			GetContext(ctx, &jobs)
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"SELECT * FROM `jobs` WHERE `status` = ? LIMIT 5 FOR UPDATE SKIP LOCKED",
+		"COMMIT",
+	}
+	if got := fake.SQL(); !reflect.DeepEqual(got, want) {
+		t.Errorf("executed SQL = %q, want %q", got, want)
+	}
+}
+
+func TestBuilder_LockModifierWithoutLock(t *testing.T) {
+	_, _, err := fluentsql.Table("jobs").SkipLocked().ToSQL()
+	if !errors.Is(err, fluentsql.ErrLockModifierWithoutLock) {
+		t.Errorf("ToSQL() error = %v, want ErrLockModifierWithoutLock", err)
+	}
+}
+
+func TestBuilder_Increment(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+
+	_, err := db.Table("posts").Where("id", "=", 7).Increment("views", 1, map[string]any{
+		"touched_at": fluentsql.NewRaw("NOW()"),
+	})
+	if err != nil {
+		t.Fatalf("Increment() error = %v", err)
+	}
+	_, err = db.Table("products").Where("id", "=", 3).Decrement("stock", 2, nil)
+	if err != nil {
+		t.Fatalf("Decrement() error = %v", err)
+	}
+
+	want := []fakeQuery{
+		{SQL: "UPDATE `posts` SET `touched_at` = NOW(), `views` = `views` + ? WHERE `id` = ?", Args: []any{int64(1), int64(7)}},
+		{SQL: "UPDATE `products` SET `stock` = `stock` - ? WHERE `id` = ?", Args: []any{int64(2), int64(3)}},
+	}
+	if got := fake.Queries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("executed = %+v, want %+v", got, want)
+	}
+
+	if _, err := db.Table("posts").Increment("views", "1; DROP TABLE posts", nil); !errors.Is(err, fluentsql.ErrInvalidValue) {
+		t.Errorf("Increment() with non-numeric amount error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestBuilder_ExpressionValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		compile  func() (string, []any, error)
+		wantSQL  string
+		wantArgs []any
+		wantErr  bool
+	}{
+		{
+			name: "insert with raw binding",
+			compile: func() (string, []any, error) {
+				return fluentsql.Table("events").ToInsertSQL(map[string]any{
+					"name":       "signup",
+					"created_at": fluentsql.NewRaw("FROM_UNIXTIME(?)", 1700000000),
+				})
+			},
+			wantSQL:  "INSERT INTO `events` (`created_at`, `name`) VALUES (FROM_UNIXTIME(?), ?)",
+			wantArgs: []any{1700000000, "signup"},
+		},
+		{
+			name: "update with expr",
+			compile: func() (string, []any, error) {
+				return fluentsql.Table("products").Where("id", "=", 1).ToUpdateSQL(map[string]any{
+					"price": fluentsql.NewExpr("price", "*", 1.1),
+				})
+			},
+			wantSQL:  "UPDATE `products` SET `price` = `price` * ? WHERE `id` = ?",
+			wantArgs: []any{1.1, 1},
+		},
+		{
+			name: "expr rejects unknown operator",
+			compile: func() (string, []any, error) {
+				return fluentsql.Table("products").ToUpdateSQL(map[string]any{
+					"price": fluentsql.NewExpr("price", "|| 0; DROP TABLE products; --", 1),
+				})
+			},
+			wantErr: true,
+		},
+		{
+			name: "expr rejects invalid column",
+			compile: func() (string, []any, error) {
+				return fluentsql.Table("products").ToUpdateSQL(map[string]any{
+					"price": fluentsql.NewExpr("price`", "+", 1),
+				})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotArgs, err := tt.compile()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotSQL != tt.wantSQL {
+				t.Errorf("SQL = %q, want %q", gotSQL, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestBuilder_UpdateFrom(t *testing.T) {
+	totals := fluentsql.Table("orders").
+		Select("user_id", "SUM(total) AS spent").
+		Where("status", "=", "paid").
+		GroupBy("user_id")
+
+	qb := fluentsql.Table("users").
+		UpdateFrom(totals, "t", "t.user_id", "=", "users.id").
+		Where("users.active", "=", true)
+
+	gotSQL, gotArgs, err := qb.ToUpdateSQL(map[string]any{
+		"lifetime_value": fluentsql.Raw{SQL: "`t`.`spent`"},
+	})
+	if err != nil {
+		t.Fatalf("ToUpdateSQL() error = %v", err)
+	}
+
+	wantSQL := "UPDATE `users` INNER JOIN (SELECT `user_id`, SUM(total) AS spent FROM `orders` WHERE `status` = ? GROUP BY `user_id`) AS `t` " +
+		"ON `t`.`user_id` = `users`.`id` SET `lifetime_value` = `t`.`spent` WHERE `users`.`active` = ?"
+	if gotSQL != wantSQL {
+		t.Errorf("ToUpdateSQL() SQL = %q, want %q", gotSQL, wantSQL)
+	}
+
+	wantArgs := []any{"paid", true}
+	if !reflect.DeepEqual(gotArgs, wantArgs) {
+		t.Errorf("ToUpdateSQL() args = %v, want %v", gotArgs, wantArgs)
+	}
+
+	if _, _, err := fluentsql.Table("users").JoinSub(nil, "t", "t.id", "=", "users.id").ToSQL(); !errors.Is(err, fluentsql.ErrNilSubquery) {
+		t.Errorf("JoinSub(nil) error = %v, want ErrNilSubquery", err)
+	}
+}
+
+func TestBuilder_InsertReturning(t *testing.T) {
+	type user struct {
+		ID   int64  `db:"id,pk"`
+		UUID string `db:"uuid"`
+		Name string `db:"name"`
+	}
+	ctx := context.Background()
+
+	t.Run("native returning", func(t *testing.T) {
+		sqlDB, fake := newFakeDB(t)
+		fake.OnQuery(func(query string, args []any) fakeResponse {
+			return fakeResponse{
+				Columns: []string{"id", "uuid"},
+				Rows:    [][]driver.Value{{int64(7), "0b1e"}},
+			}
+		})
+		db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(dialect.MariaDB()))
+
+		var got user
+		err := db.Table("users").Returning("id", "uuid").
+			InsertReturningContext(ctx, map[string]any{"name": "Ahmet"}, &got)
+		if err != nil {
+			t.Fatalf("InsertReturningContext() error = %v", err)
+		}
+		if got.ID != 7 || got.UUID != "0b1e" {
+			t.Errorf("InsertReturningContext() dest = %+v", got)
+		}
+
+		want := []string{"INSERT INTO `users` (`name`) VALUES (?) RETURNING `id`, `uuid`"}
+		if !reflect.DeepEqual(fake.SQL(), want) {
+			t.Errorf("executed SQL = %v, want %v", fake.SQL(), want)
+		}
+	})
+
+	t.Run("postgres update and delete", func(t *testing.T) {
+		sqlDB, fake := newFakeDB(t)
+		fake.OnQuery(func(query string, args []any) fakeResponse {
+			return fakeResponse{
+				Columns: []string{"id", "name"},
+				Rows:    [][]driver.Value{{int64(3), "x"}},
+			}
+		})
+		db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(dialect.Postgres()))
+
+		var updated []user
+		err := db.Table("users").Where("id", "=", 3).Returning("id", "name").
+			UpdateReturningContext(ctx, map[string]any{"name": "x"}, &updated)
+		if err != nil {
+			t.Fatalf("UpdateReturningContext() error = %v", err)
+		}
+		var deleted user
+		if err := db.Table("users").Where("id", "=", 3).DeleteReturningContext(ctx, &deleted); err != nil {
+			t.Fatalf("DeleteReturningContext() error = %v", err)
+		}
+		if len(updated) != 1 || updated[0].ID != 3 || deleted.ID != 3 {
+			t.Errorf("dest = %+v, %+v", updated, deleted)
+		}
+
+		want := []string{
+			`UPDATE "users" SET "name" = $1 WHERE "id" = $2 RETURNING "id", "name"`,
+			`DELETE FROM "users" WHERE "id" = $1 RETURNING *`,
+		}
+		if !reflect.DeepEqual(fake.SQL(), want) {
+			t.Errorf("executed SQL = %v, want %v", fake.SQL(), want)
+		}
+	})
+
+	t.Run("mysql emulation", func(t *testing.T) {
+		sqlDB, fake := newFakeDB(t)
+		fake.OnQuery(func(query string, args []any) fakeResponse {
+			if strings.HasPrefix(query, "SELECT") {
+				return fakeResponse{
+					Columns: []string{"id", "uuid", "name"},
+					Rows:    [][]driver.Value{{int64(42), "9f3c", "Ahmet"}},
+				}
+			}
+			return fakeResponse{LastInsertID: 42, RowsAffected: 1}
+		})
+		db := fluentsql.NewDB(sqlDB)
+
+		var got []user
+		err := db.Table("users").InsertReturningContext(ctx, map[string]any{"name": "Ahmet"}, &got)
+		if err != nil {
+			t.Fatalf("InsertReturningContext() error = %v", err)
+		}
+		if len(got) != 1 || got[0].ID != 42 || got[0].UUID != "9f3c" {
+			t.Errorf("InsertReturningContext() dest = %+v", got)
+		}
+
+		queries := fake.Queries()
+		if len(queries) != 2 {
+			t.Fatalf("executed SQL = %v, want insert + select", fake.SQL())
+		}
+		if want := "SELECT * FROM `users` WHERE `id` = ? LIMIT 1"; queries[1].SQL != want {
+			t.Errorf("follow-up SQL = %q, want %q", queries[1].SQL, want)
+		}
+		if !reflect.DeepEqual(queries[1].Args, []any{int64(42)}) {
+			t.Errorf("follow-up args = %v", queries[1].Args)
+		}
+	})
+
+	t.Run("mysql emulation without a key reports the committed write", func(t *testing.T) {
+		sqlDB, fake := newFakeDB(t)
+		fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{RowsAffected: 1} })
+		db := fluentsql.NewDB(sqlDB)
+
+		var got user
+		err := db.Table("users").InsertReturningContext(ctx, map[string]any{"name": "Ahmet"}, &got)
+		if !errors.Is(err, fluentsql.ErrReturningAfterWrite) || errors.Is(err, dialect.ErrReturningNotSupported) {
+			t.Fatalf("InsertReturningContext() error = %v, want ErrReturningAfterWrite", err)
+		}
+		if len(fake.Queries()) != 1 {
+			t.Errorf("executed SQL = %v, want the insert only", fake.SQL())
+		}
+	})
+
+	t.Run("mysql emulation rejects an expression key before inserting", func(t *testing.T) {
+		sqlDB, fake := newFakeDB(t)
+		db := fluentsql.NewDB(sqlDB)
+
+		var got user
+		err := db.Table("users").InsertReturningContext(ctx, map[string]any{"id": fluentsql.NewRaw("UUID_SHORT()"), "name": "Ahmet"}, &got)
+		if !errors.Is(err, dialect.ErrReturningNotSupported) {
+			t.Fatalf("InsertReturningContext() error = %v, want ErrReturningNotSupported", err)
+		}
+		if len(fake.Queries()) != 0 {
+			t.Errorf("no SQL should reach the driver, got %v", fake.SQL())
+		}
+	})
+
+	t.Run("mysql update is rejected", func(t *testing.T) {
+		sqlDB, fake := newFakeDB(t)
+		db := fluentsql.NewDB(sqlDB)
+
+		var got []user
+		err := db.Table("users").Where("id", "=", 1).UpdateReturningContext(ctx, map[string]any{"name": "x"}, &got)
+		if !errors.Is(err, dialect.ErrReturningNotSupported) {
+			t.Fatalf("UpdateReturningContext() error = %v, want ErrReturningNotSupported", err)
+		}
+		if len(fake.Queries()) != 0 {
+			t.Errorf("no SQL should reach the driver, got %v", fake.SQL())
+		}
+	})
+}
+
+func TestBuilder_TablePrefix(t *testing.T) {
+	newQB := func() *fluentsql.Builder {
+		return fluentsql.New(
+			fluentsql.WithTablePrefix("acme_"),
+			fluentsql.WithUnprefixedTables("tenants"),
+		)
+	}
+
+	t.Run("select with joins and qualified columns", func(t *testing.T) {
+		latest := fluentsql.Table("orders").Select("user_id").Where("orders.status", "=", "paid")
+
+		gotSQL, _, err := newQB().Table("users as u").
+			Select("u.id", "profiles.bio", "tenants.name").
+			Join("profiles", "profiles.user_id", "=", "u.id").
+			Join("tenants", "tenants.id", "=", "u.tenant_id").
+			JoinSub(latest, "o", "o.user_id", "=", "u.id").
+			Where("profiles.public", "=", true).
+			OrderBy("profiles.updated_at", dialect.OrderDesc).
+			ToSQL()
+		if err != nil {
+			t.Fatalf("ToSQL() error = %v", err)
+		}
+
+		wantSQL := "SELECT `u`.`id`, `acme_profiles`.`bio`, `tenants`.`name` FROM `acme_users` AS `u` " +
+			"INNER JOIN `acme_profiles` ON `acme_profiles`.`user_id` = `u`.`id` " +
+			"INNER JOIN `tenants` ON `tenants`.`id` = `u`.`tenant_id` " +
+			"INNER JOIN (SELECT `user_id` FROM `acme_orders` WHERE `acme_orders`.`status` = ?) AS `o` ON `o`.`user_id` = `u`.`id` " +
+			"WHERE `acme_profiles`.`public` = ? ORDER BY `acme_profiles`.`updated_at` DESC"
+		if gotSQL != wantSQL {
+			t.Errorf("ToSQL() SQL =\n%q\nwant\n%q", gotSQL, wantSQL)
+		}
+	})
+
+	t.Run("write targets", func(t *testing.T) {
+		qb := newQB().Table("users")
+
+		gotSQL, _, err := qb.ToInsertSQL(map[string]any{"name": "x"})
+		if err != nil {
+			t.Fatalf("ToInsertSQL() error = %v", err)
+		}
+		if want := "INSERT INTO `acme_users` (`name`) VALUES (?)"; gotSQL != want {
+			t.Errorf("ToInsertSQL() SQL = %q, want %q", gotSQL, want)
+		}
+
+		gotSQL, _, err = newQB().Table("users").
+			Join("tenants", "tenants.id", "=", "users.tenant_id").
+			Where("tenants.active", "=", false).
+			ToUpdateSQL(map[string]any{"users.disabled": true})
+		if err != nil {
+			t.Fatalf("ToUpdateSQL() error = %v", err)
+		}
+		want := "UPDATE `acme_users` INNER JOIN `tenants` ON `tenants`.`id` = `acme_users`.`tenant_id` " +
+			"SET `acme_users`.`disabled` = ? WHERE `tenants`.`active` = ?"
+		if gotSQL != want {
+			t.Errorf("ToUpdateSQL() SQL = %q, want %q", gotSQL, want)
+		}
+
+		// Builder'ın kendi durumu değişmemeli; tekrar derleme öneki iki kez eklememeli
+		again, _, _ := qb.ToDeleteSQL()
+		if want := "DELETE FROM `acme_users`"; again != want {
+			t.Errorf("ToDeleteSQL() SQL = %q, want %q", again, want)
+		}
+		if qb.Clone().GetTable() != "acme_users" {
+			t.Errorf("Clone().GetTable() = %q, want acme_users", qb.Clone().GetTable())
+		}
+	})
+
+	t.Run("opted-out table", func(t *testing.T) {
+		gotSQL, _, err := newQB().Table("tenants").Where("id", "=", 1).ToSQL()
+		if err != nil {
+			t.Fatalf("ToSQL() error = %v", err)
+		}
+		if want := "SELECT * FROM `tenants` WHERE `id` = ?"; gotSQL != want {
+			t.Errorf("ToSQL() SQL = %q, want %q", gotSQL, want)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+func newFakeCluster(t *testing.T) (*fluentsql.DB, *fakeDB, []*fakeDB, *fluentsql.Cluster) {
+	t.Helper()
+
+	primary, primaryFake := newFakeDB(t)
+	r1, r1Fake := newFakeDB(t)
+	r2, r2Fake := newFakeDB(t)
+
+	cluster := fluentsql.NewCluster(primary, r1, r2)
+	db := fluentsql.NewDB(primary, fluentsql.WithCluster(cluster))
+	return db, primaryFake, []*fakeDB{r1Fake, r2Fake}, cluster
+}
+
+func TestCluster_RoutesReadsAndWrites(t *testing.T) {
+	db, primary, replicas, _ := newFakeCluster(t)
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		if _, err := db.Table("users").CountContext(ctx); err != nil {
+			t.Fatalf("CountContext() error = %v", err)
+		}
+	}
+	if _, err := db.Table("users").Where("id", "=", 1).UpdateContext(ctx, map[string]any{"name": "a"}); err != nil {
+		t.Fatalf("UpdateContext() error = %v", err)
+	}
+	if _, err := db.Table("users").UsePrimary().CountContext(ctx); err != nil {
+		t.Fatalf("CountContext() error = %v", err)
+	}
+
+	// Round-robin: her replica iki okuma alır
+	for i, r := range replicas {
+		if n := len(r.Queries()); n != 2 {
+			t.Errorf("replica %d ran %d queries, want 2", i, n)
+		}
+	}
+	if got := primary.SQL(); len(got) != 2 {
+		t.Errorf("primary ran %v, want the update and the UsePrimary read", got)
+	}
+}
+
+func TestCluster_TransactionPinsPrimary(t *testing.T) {
+	db, primary, replicas, _ := newFakeCluster(t)
+	ctx := context.Background()
+
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		_, err := tx.Table("users").Where("id", "=", 1).CountContext(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	if got := primary.SQL(); len(got) != 3 {
+		t.Errorf("primary ran %v, want BEGIN, count and COMMIT", got)
+	}
+	for i, r := range replicas {
+		if n := len(r.Queries()); n != 0 {
+			t.Errorf("replica %d ran %d queries inside a transaction", i, n)
+		}
+	}
+}
+
+func TestCluster_HealthCheck(t *testing.T) {
+	db, primary, replicas, cluster := newFakeCluster(t)
+	ctx := context.Background()
+
+	down := errors.New("connection refused")
+	replicas[0].OnQuery(func(query string, _ []any) fakeResponse {
+		if query == "PING" {
+			return fakeResponse{Err: down}
+		}
+		return fakeResponse{}
+	})
+
+	if err := cluster.CheckHealth(ctx); !errors.Is(err, down) {
+		t.Fatalf("CheckHealth() error = %v, want %v", err, down)
+	}
+	if got := cluster.Healthy(); len(got) != 1 {
+		t.Fatalf("Healthy() = %d replicas, want 1", len(got))
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := db.Table("users").CountContext(ctx); err != nil {
+			t.Fatalf("CountContext() error = %v", err)
+		}
+	}
+	if n := len(replicas[0].SQL()); n != 1 { // yalnızca PING
+		t.Errorf("unhealthy replica ran %d statements, want only the ping", n)
+	}
+
+	// Tüm replica'lar düşerse okumalar primary'ye kayar
+	replicas[1].OnQuery(replicas[0].respond)
+	_ = cluster.CheckHealth(ctx)
+	if got := cluster.Reader(); got != cluster.Primary() {
+		t.Errorf("Reader() = %p, want primary %p", got, cluster.Primary())
+	}
+	if _, err := db.Table("users").CountContext(ctx); err != nil {
+		t.Fatalf("CountContext() error = %v", err)
+	}
+	if got := primary.SQL(); len(got) != 1 {
+		t.Errorf("primary ran %v, want the fallback read", got)
+	}
+}
+
+func TestBalancers(t *testing.T) {
+	a, _ := newFakeDB(t)
+	b, _ := newFakeDB(t)
+	replicas := []*sql.DB{a, b}
+
+	rr := fluentsql.RoundRobin()
+	if rr.Pick(replicas) != a || rr.Pick(replicas) != b || rr.Pick(replicas) != a {
+		t.Error("RoundRobin did not cycle through replicas in order")
+	}
+	if got := fluentsql.LeastConnections().Pick(replicas); got != a {
+		t.Error("LeastConnections should pick the first replica on a tie")
+	}
+	for i := 0; i < 10; i++ {
+		if got := fluentsql.Random().Pick(replicas); got != a && got != b {
+			t.Fatal("Random picked a connection outside the replica set")
+		}
+	}
+}
+
+func TestCluster_ReadOnlyTransactionUsesReplica(t *testing.T) {
+	db, primary, replicas, _ := newFakeCluster(t)
+	ctx := context.Background()
+
+	err := db.ReadOnlyTransaction(ctx, func(tx *fluentsql.Transaction) error {
+		_, err := tx.Table("users").CountContext(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("ReadOnlyTransaction() error = %v", err)
+	}
+	if got := primary.SQL(); len(got) != 0 {
+		t.Errorf("primary received %q, want nothing", got)
+	}
+	if got := append(replicas[0].SQL(), replicas[1].SQL()...); len(got) != 3 || got[0] != "BEGIN" || got[2] != "COMMIT" {
+		t.Errorf("replica SQL = %q", got)
+	}
+
+	// Yazma transaction'ları primary'de kalır
+	err = db.TransactionWithOptions(ctx, fluentsql.IsolationReadCommitted, func(tx *fluentsql.Transaction) error {
+		_, err := tx.Table("users").CountContext(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("TransactionWithOptions() error = %v", err)
+	}
+	if got := primary.SQL(); len(got) != 3 {
+		t.Errorf("primary SQL = %q, want BEGIN/SELECT/COMMIT", got)
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+func TestConfig_DSN(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  fluentsql.Config
+		want string
+	}{
+		{
+			name: "mysql",
+			cfg:  fluentsql.Config{Driver: "mysql", Host: "localhost", Port: 3306, Database: "app", Username: "root", Password: "secret", Charset: "utf8mb4"},
+			want: "root:secret@tcp(localhost:3306)/app?charset=utf8mb4&parseTime=true",
+		},
+		{
+			name: "postgres escapes credentials",
+			cfg:  fluentsql.Config{Driver: "postgresql", Host: "db", Port: 5432, Database: "app", Username: "deploy", Password: "p@ss/word"},
+			want: "postgres://deploy:p%40ss%2Fword@db:5432/app?sslmode=disable",
+		},
+		{
+			name: "postgres with tls",
+			cfg:  fluentsql.Config{Driver: "pgx", Host: "db", Database: "app", TLS: true},
+			want: "postgres://db/app?sslmode=require",
+		},
+		{
+			name: "sqlite",
+			cfg:  fluentsql.Config{Driver: "sqlite3", Database: "/var/lib/app.db"},
+			want: "/var/lib/app.db",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.DSN(); got != tt.want {
+				t.Errorf("DSN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+var driverAliasOnce sync.Once
+
+func TestConnect_GrammarFromDriver(t *testing.T) {
+	// Sahte sürücüyü gerçek sürücü adlarıyla kaydet; kök modül bu sürücüleri içermez
+	driverAliasOnce.Do(func() {
+		sql.Register("pgx", fakeDriver{})
+		sql.Register("sqlite3", fakeDriver{})
+	})
+	fakeInstances.Store("grammar-from-driver", &fakeDB{})
+	t.Cleanup(func() { fakeInstances.Delete("grammar-from-driver") })
+
+	tests := []struct {
+		driver string
+		opts   []fluentsql.Option
+		want   string
+	}{
+		{driver: "pgx", want: "postgres"},
+		{driver: "sqlite3", want: "sqlite"},
+		{driver: "fluentsql-fake", want: "mysql"},
+		{driver: "pgx", opts: []fluentsql.Option{fluentsql.WithGrammar(dialect.MariaDB())}, want: "mariadb"},
+	}
+	newFakeDB(t) // "fluentsql-fake" kaydı için
+
+	for _, tt := range tests {
+		t.Run(tt.driver+"→"+tt.want, func(t *testing.T) {
+			db, err := fluentsql.Connect(tt.driver, "grammar-from-driver", tt.opts...)
+			if err != nil {
+				t.Fatalf("Connect() error = %v", err)
+			}
+			defer db.Close()
+
+			if got := db.Grammar().Name(); got != tt.want {
+				t.Errorf("Grammar().Name() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	db, err := fluentsql.Connect("pgx", "grammar-from-driver")
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer db.Close()
+
+	gotSQL, _, err := db.Table("users").Where("id", "=", 1).ToSQL()
+	if err != nil {
+		t.Fatalf("ToSQL() error = %v", err)
+	}
+	if want := `SELECT * FROM "users" WHERE "id" = $1`; gotSQL != want {
+		t.Errorf("ToSQL() = %q, want %q", gotSQL, want)
+	}
+}
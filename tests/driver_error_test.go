@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+func TestMySQLGrammar_ClassifyError(t *testing.T) {
+	g := dialect.MySQL()
+	tests := []struct {
+		name string
+		code dialect.ErrorCode
+		want dialect.ErrorClassification
+	}{
+		{
+			"duplicate entry",
+			dialect.ErrorCode{Number: 1062, SQLState: "23000", Message: "Duplicate entry 'a@b.c' for key 'users.users_email_unique'"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindUniqueViolation, Constraint: "users_email_unique"},
+		},
+		{
+			"foreign key",
+			dialect.ErrorCode{Number: 1452, SQLState: "23000", Message: "Cannot add or update a child row: a foreign key constraint fails (`shop`.`orders`, CONSTRAINT `orders_user_id_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindForeignKeyViolation, Constraint: "orders_user_id_fk", Column: "user_id"},
+		},
+		{
+			"not null",
+			dialect.ErrorCode{Number: 1048, SQLState: "23000", Message: "Column 'name' cannot be null"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindNotNullViolation, Column: "name"},
+		},
+		{
+			"check",
+			dialect.ErrorCode{Number: 3819, SQLState: "HY000", Message: "Check constraint 'price_positive' is violated."},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindCheckViolation, Constraint: "price_positive"},
+		},
+		{
+			"mariadb check",
+			dialect.ErrorCode{Number: 4025, SQLState: "23000", Message: "CONSTRAINT `price_positive` failed for `shop`.`products`"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindCheckViolation, Constraint: "price_positive"},
+		},
+		{
+			"deadlock wins over sqlstate",
+			dialect.ErrorCode{Number: 1213, SQLState: "40001"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindDeadlock},
+		},
+		{
+			"nowait",
+			dialect.ErrorCode{Number: 3572, SQLState: "HY000"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindLockTimeout},
+		},
+		{
+			"sqlstate fallback",
+			dialect.ErrorCode{SQLState: "23505"},
+			dialect.ErrorClassification{Kind: dialect.ErrorKindUniqueViolation},
+		},
+		{
+			"unknown",
+			dialect.ErrorCode{Number: 1146, SQLState: "42S02", Message: "Table 'shop.nope' doesn't exist"},
+			dialect.ErrorClassification{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.ClassifyError(tt.code); got != tt.want {
+				t.Errorf("ClassifyError() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseError_Classification(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	dup := &mysqlError{Number: 1062, SQLState: [5]byte{'2', '3', '0', '0', '0'}, Message: "Duplicate entry 'a@b.c' for key 'users.users_email_unique'"}
+	fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: dup} })
+
+	_, err := db.Table("users").InsertContext(ctx, map[string]any{"email": "a@b.c"})
+	if !errors.Is(err, fluentsql.ErrUniqueViolation) {
+		t.Fatalf("InsertContext() error = %v, want ErrUniqueViolation", err)
+	}
+	if errors.Is(err, fluentsql.ErrForeignKeyViolation) {
+		t.Error("unique violation also matched ErrForeignKeyViolation")
+	}
+
+	// Orijinal sürücü hatası erişilebilir kalır
+	var driverErr *mysqlError
+	if !errors.As(err, &driverErr) || driverErr != dup {
+		t.Errorf("errors.As(driver error) failed for %v", err)
+	}
+
+	var qe *fluentsql.QueryError
+	if !errors.As(err, &qe) || qe.Constraint() != "users_email_unique" || qe.Column() != "" {
+		t.Errorf("QueryError constraint/column not exposed: %v", err)
+	}
+	if got := fluentsql.ErrorClass(err); got != fluentsql.ErrorClassConstraint {
+		t.Errorf("ErrorClass() = %q, want %q", got, fluentsql.ErrorClassConstraint)
+	}
+
+	// Tanınmayan hatalar sarılmaz
+	plain := errors.New("Error 1146 (42S02): Table 'shop.nope' doesn't exist")
+	fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: plain} })
+	_, err = db.Table("nope").Where("id", "=", 1).DeleteContext(ctx)
+	var dbErr *fluentsql.DatabaseError
+	if errors.As(err, &dbErr) || !errors.Is(err, plain) {
+		t.Errorf("unknown error was classified: %v", err)
+	}
+}
+
+func TestDatabaseError_QueryRowClassification(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	deadlock := &mysqlError{Number: 1213, SQLState: [5]byte{'4', '0', '0', '0', '1'}, Message: "Deadlock found when trying to get lock"}
+	fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: deadlock} })
+
+	var user struct {
+		ID int64 `db:"id"`
+	}
+	if err := db.Table("users").Where("id", "=", 1).FirstContext(ctx, &user); !errors.Is(err, fluentsql.ErrDeadlock) {
+		t.Errorf("FirstContext() error = %v, want ErrDeadlock", err)
+	}
+	if _, err := db.Table("users").CountContext(ctx); !errors.Is(err, fluentsql.ErrDeadlock) {
+		t.Errorf("CountContext() error = %v, want ErrDeadlock", err)
+	}
+	if _, err := db.Table("users").ExistsContext(ctx); !errors.Is(err, fluentsql.ErrDeadlock) {
+		t.Errorf("ExistsContext() error = %v, want ErrDeadlock", err)
+	}
+
+	var driverErr *mysqlError
+	if _, err := db.Table("users").CountContext(ctx); !errors.As(err, &driverErr) || driverErr != deadlock {
+		t.Errorf("errors.As(driver error) failed for %v", err)
+	}
+}
+
+// pqError ve pgconnError, lib/pq ve pgx hata tiplerinin yapısal alanlarını
+// taklit eder. Mesajlar constraint adını içermez; adlar yalnızca alanlardan
+// okunabilir.
+type pqError struct {
+	Code       string
+	Constraint string
+	Column     string
+}
+
+func (e *pqError) Error() string { return "pq: constraint violation" }
+
+type pgconnError struct {
+	Code           string
+	ConstraintName string
+	ColumnName     string
+}
+
+func (e *pgconnError) Error() string { return "ERROR: constraint violation (SQLSTATE " + e.Code + ")" }
+
+func TestDatabaseError_PostgresDriverFields(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(dialect.Postgres()))
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		err        error
+		want       error
+		constraint string
+		column     string
+	}{
+		{"lib/pq unique", &pqError{Code: "23505", Constraint: "users_email_key"}, fluentsql.ErrUniqueViolation, "users_email_key", ""},
+		{"lib/pq not null", &pqError{Code: "23502", Column: "email"}, fluentsql.ErrNotNullViolation, "", "email"},
+		{"pgx foreign key", &pgconnError{Code: "23503", ConstraintName: "orders_user_id_fkey"}, fluentsql.ErrForeignKeyViolation, "orders_user_id_fkey", ""},
+		{"pgx not null", &pgconnError{Code: "23502", ColumnName: "name"}, fluentsql.ErrNotNullViolation, "", "name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: tt.err} })
+
+			_, err := db.Table("users").InsertContext(ctx, map[string]any{"email": "a@b.c"})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("InsertContext() error = %v, want %v", err, tt.want)
+			}
+			var qe *fluentsql.QueryError
+			if !errors.As(err, &qe) {
+				t.Fatalf("errors.As(QueryError) failed for %v", err)
+			}
+			if qe.Constraint() != tt.constraint || qe.Column() != tt.column {
+				t.Errorf("QueryError constraint/column = %q/%q, want %q/%q", qe.Constraint(), qe.Column(), tt.constraint, tt.column)
+			}
+		})
+	}
+}
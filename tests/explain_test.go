@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+const mysqlExplainJSON = `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "12.50"},
+    "nested_loop": [
+      {"table": {"table_name": "o", "access_type": "ALL", "possible_keys": ["idx_status"],
+                 "rows_examined_per_scan": 120, "filtered": "10.00"}},
+      {"table": {"table_name": "u", "access_type": "eq_ref", "possible_keys": ["PRIMARY"], "key": "PRIMARY",
+                 "rows_examined_per_scan": 1, "filtered": "100.00"}}
+    ]
+  }
+}`
+
+// respondExplain, EXPLAIN sorgularına örnek bir JSON plan döndürür.
+func respondExplain(query string, _ []any) fakeResponse {
+	if strings.HasPrefix(query, "EXPLAIN FORMAT=JSON ") {
+		return fakeResponse{Columns: []string{"EXPLAIN"}, Rows: [][]driver.Value{{mysqlExplainJSON}}}
+	}
+	return fakeResponse{Columns: []string{"id"}}
+}
+
+func TestMySQLGrammar_CompileExplain(t *testing.T) {
+	g := dialect.MySQL()
+
+	got, err := g.CompileExplain("/* app */ SELECT * FROM `users`")
+	if err != nil || got != "EXPLAIN FORMAT=JSON /* app */ SELECT * FROM `users`" {
+		t.Errorf("CompileExplain(select) = %q, %v", got, err)
+	}
+	if _, err := g.CompileExplain("TRUNCATE TABLE `users`"); !errors.Is(err, dialect.ErrExplainNotSupported) {
+		t.Errorf("CompileExplain(truncate) error = %v, want ErrExplainNotSupported", err)
+	}
+}
+
+func TestBuilder_Explain(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(respondExplain)
+	db := fluentsql.NewDB(sqlDB)
+
+	plan, err := db.Table("orders as o").
+		Join("users as u", "u.id", "=", "o.user_id").
+		Where("o.status", "=", "open").
+		Explain(context.Background())
+	if err != nil {
+		t.Fatalf("Explain() error = %v", err)
+	}
+
+	wantSQL := "SELECT * FROM `orders` AS `o` INNER JOIN `users` AS `u` ON `u`.`id` = `o`.`user_id` WHERE `o`.`status` = ?"
+	if plan.SQL != wantSQL {
+		t.Errorf("plan.SQL = %q, want %q", plan.SQL, wantSQL)
+	}
+	if q := fake.Queries()[0]; q.SQL != "EXPLAIN FORMAT=JSON "+wantSQL || !reflect.DeepEqual(q.Args, []any{"open"}) {
+		t.Errorf("driver query = %+v", q)
+	}
+	if plan.Cost != 12.5 {
+		t.Errorf("plan.Cost = %v, want 12.5", plan.Cost)
+	}
+	want := []fluentsql.ExplainTable{
+		{Name: "o", AccessType: "ALL", PossibleKeys: []string{"idx_status"}, Rows: 120, Filtered: 10},
+		{Name: "u", AccessType: "eq_ref", Key: "PRIMARY", PossibleKeys: []string{"PRIMARY"}, Rows: 1, Filtered: 100},
+	}
+	if !reflect.DeepEqual(plan.Tables, want) {
+		t.Errorf("plan.Tables = %+v, want %+v", plan.Tables, want)
+	}
+	if got := plan.FullScans(); !reflect.DeepEqual(got, []string{"o"}) {
+		t.Errorf("FullScans() = %v, want [o]", got)
+	}
+}
+
+func TestDB_Explain(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(respondExplain)
+	db := fluentsql.NewDB(sqlDB)
+
+	plan, err := db.Explain(context.Background(), "SELECT * FROM orders WHERE status = ?", "open")
+	if err != nil {
+		t.Fatalf("Explain() error = %v", err)
+	}
+	if q := fake.Queries()[0]; q.SQL != "EXPLAIN FORMAT=JSON SELECT * FROM orders WHERE status = ?" || !reflect.DeepEqual(q.Args, []any{"open"}) {
+		t.Errorf("driver query = %+v", q)
+	}
+	if plan.Cost != 12.5 || len(plan.Tables) != 2 {
+		t.Errorf("plan = %+v", plan)
+	}
+}
+
+func TestSlowQuery_CapturesPlan(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(respondExplain)
+
+	var slow []fluentsql.SlowQuery
+	db := fluentsql.NewDB(sqlDB,
+		fluentsql.WithSlowQueryThreshold(time.Nanosecond, func(_ context.Context, q fluentsql.SlowQuery) {
+			slow = append(slow, q)
+		}),
+		fluentsql.WithSlowQueryExplain(true),
+	)
+
+	if _, err := db.Table("orders").Where("status", "=", "open").CountContext(context.Background()); err != nil {
+		t.Fatalf("CountContext() error = %v", err)
+	}
+
+	if len(slow) != 1 {
+		t.Fatalf("handler called %d times, want 1", len(slow))
+	}
+	q := slow[0]
+	if q.Table != "orders" || q.Duration <= 0 || q.ExplainErr != nil || q.Plan == nil {
+		t.Fatalf("slow query = %+v", q)
+	}
+	if q.Plan.SQL != q.SQL || len(q.Plan.Tables) != 2 {
+		t.Errorf("plan = %+v, want plan for %q", q.Plan, q.SQL)
+	}
+
+	queries := fake.Queries()
+	if len(queries) != 2 || queries[1].SQL != "EXPLAIN FORMAT=JSON "+q.SQL || !reflect.DeepEqual(queries[1].Args, []any{"open"}) {
+		t.Errorf("driver queries = %+v, want original query followed by its EXPLAIN", queries)
+	}
+}
+
+func TestSlowQuery_ExplainInTransaction(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	sqlDB.SetMaxOpenConns(1)
+	fake.OnQuery(respondExplain)
+
+	var slow []fluentsql.SlowQuery
+	db := fluentsql.NewDB(sqlDB,
+		fluentsql.WithSlowQueryThreshold(time.Nanosecond, func(_ context.Context, q fluentsql.SlowQuery) {
+			slow = append(slow, q)
+		}),
+		fluentsql.WithSlowQueryExplain(true),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		if _, err := tx.Table("orders").Where("id", "=", 1).UpdateContext(ctx, map[string]any{"status": "paid"}); err != nil {
+			return err
+		}
+		_, err := tx.Table("orders").CountContext(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	if len(slow) != 2 {
+		t.Fatalf("handler called %d times, want 2", len(slow))
+	}
+	if slow[0].ExplainErr != nil || slow[0].Plan == nil {
+		t.Errorf("exec in transaction: plan = %v, error = %v; want a plan from the transaction connection", slow[0].Plan, slow[0].ExplainErr)
+	}
+	if !errors.Is(slow[1].ExplainErr, fluentsql.ErrExplainSkipped) {
+		t.Errorf("query in transaction: ExplainErr = %v, want ErrExplainSkipped", slow[1].ExplainErr)
+	}
+}
+
+func TestParseExplain_Postgres(t *testing.T) {
+	const postgresExplainJSON = `[{"Plan": {"Node Type": "Nested Loop", "Total Cost": 42.75, "Plan Rows": 3, "Plans": [
+		{"Node Type": "Seq Scan", "Relation Name": "orders", "Alias": "o", "Total Cost": 30.1, "Plan Rows": 120},
+		{"Node Type": "Index Scan", "Relation Name": "users", "Alias": "u", "Index Name": "users_pkey", "Plan Rows": 1}
+	]}}]`
+
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		return fakeResponse{Columns: []string{"QUERY PLAN"}, Rows: [][]driver.Value{{postgresExplainJSON}}}
+	})
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(dialect.Postgres()))
+
+	plan, err := db.Table("orders").Where("status", "=", "open").Explain(context.Background())
+	if err != nil {
+		t.Fatalf("Explain() error = %v", err)
+	}
+	if want := `EXPLAIN (FORMAT JSON) SELECT * FROM "orders" WHERE "status" = $1`; fake.SQL()[0] != want {
+		t.Errorf("executed SQL = %q, want %q", fake.SQL()[0], want)
+	}
+	if plan.Cost != 42.75 {
+		t.Errorf("Cost = %v, want 42.75", plan.Cost)
+	}
+	want := []fluentsql.ExplainTable{
+		{Name: "o", AccessType: "Seq Scan", Rows: 120},
+		{Name: "u", AccessType: "Index Scan", Key: "users_pkey", Rows: 1},
+	}
+	if !reflect.DeepEqual(plan.Tables, want) {
+		t.Errorf("Tables = %+v, want %+v", plan.Tables, want)
+	}
+	if !reflect.DeepEqual(plan.FullScans(), []string{"o"}) {
+		t.Errorf("FullScans() = %v", plan.FullScans())
+	}
+}
@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeDB, gerçek bir veritabanı olmadan Builder/Transaction yürütme yollarını
+// test etmek için kullanılan kayıt tutan sahte sürücüdür. Çalıştırılan her
+// ifade (BEGIN/COMMIT/ROLLBACK dahil) sırasıyla kaydedilir; yanıtlar ise
+// respond fonksiyonu ile senaryoya göre belirlenir.
+type fakeDB struct {
+	mu      sync.Mutex
+	queries []fakeQuery
+	respond func(query string, args []any) fakeResponse
+	txOpts  []driver.TxOptions
+}
+
+// fakeQuery, sürücüye ulaşan tek bir ifadeyi temsil eder.
+type fakeQuery struct {
+	SQL  string
+	Args []any
+}
+
+// fakeResponse, sahte sürücünün bir ifadeye vereceği yanıttır.
+type fakeResponse struct {
+	Columns      []string
+	Rows         [][]driver.Value
+	LastInsertID int64
+	RowsAffected int64
+	Err          error
+}
+
+var (
+	fakeRegisterOnce sync.Once
+	fakeInstances    sync.Map // dsn → *fakeDB
+	fakeCounter      atomic.Int64
+)
+
+// newFakeDB, test boyunca yaşayan yeni bir sahte bağlantı havuzu açar.
+func newFakeDB(t *testing.T) (*sql.DB, *fakeDB) {
+	t.Helper()
+
+	fakeRegisterOnce.Do(func() {
+		sql.Register("fluentsql-fake", fakeDriver{})
+	})
+
+	fake := &fakeDB{}
+	dsn := fmt.Sprintf("fake-%d", fakeCounter.Add(1))
+	fakeInstances.Store(dsn, fake)
+
+	db, err := sql.Open("fluentsql-fake", dsn)
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		fakeInstances.Delete(dsn)
+	})
+
+	return db, fake
+}
+
+// TxOptions, açılan transaction'ların seçeneklerini sırasıyla döndürür.
+func (f *fakeDB) TxOptions() []driver.TxOptions {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]driver.TxOptions(nil), f.txOpts...)
+}
+
+// OnQuery, ifadelere verilecek yanıtı belirler.
+func (f *fakeDB) OnQuery(fn func(query string, args []any) fakeResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.respond = fn
+}
+
+// Queries, o ana kadar çalıştırılan ifadelerin kopyasını döndürür.
+func (f *fakeDB) Queries() []fakeQuery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fakeQuery(nil), f.queries...)
+}
+
+// SQL, o ana kadar çalıştırılan ifadelerin yalnızca SQL metinlerini döndürür.
+func (f *fakeDB) SQL() []string {
+	queries := f.Queries()
+	out := make([]string, len(queries))
+	for i, q := range queries {
+		out[i] = q.SQL
+	}
+	return out
+}
+
+func (f *fakeDB) record(query string, args []driver.NamedValue) fakeResponse {
+	values := make([]any, len(args))
+	for i, a := range args {
+		values[i] = a.Value
+	}
+
+	f.mu.Lock()
+	f.queries = append(f.queries, fakeQuery{SQL: query, Args: values})
+	respond := f.respond
+	f.mu.Unlock()
+
+	if respond == nil {
+		return fakeResponse{RowsAffected: 1, LastInsertID: 1}
+	}
+	return respond(query, values)
+}
+
+// -----------------------------------------------------------------------------
+// database/sql/driver implementasyonu
+// -----------------------------------------------------------------------------
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	v, ok := fakeInstances.Load(dsn)
+	if !ok {
+		return nil, errors.New("fakedb: unknown dsn " + dsn)
+	}
+	return &fakeConn{db: v.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("fakedb: prepare not supported")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.db.mu.Lock()
+	c.db.txOpts = append(c.db.txOpts, opts)
+	c.db.mu.Unlock()
+
+	if resp := c.db.record("BEGIN", nil); resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &fakeTx{conn: c}, nil
+}
+
+func (c *fakeConn) Ping(ctx context.Context) error {
+	return c.db.record("PING", nil).Err
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	resp := c.db.record(query, args)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return fakeResult{lastInsertID: resp.LastInsertID, rowsAffected: resp.RowsAffected}, nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	resp := c.db.record(query, args)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return &fakeRows{columns: resp.Columns, rows: resp.Rows}, nil
+}
+
+type fakeTx struct {
+	conn *fakeConn
+}
+
+func (tx *fakeTx) Commit() error   { return tx.conn.db.record("COMMIT", nil).Err }
+func (tx *fakeTx) Rollback() error { return tx.conn.db.record("ROLLBACK", nil).Err }
+
+type fakeResult struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return r.lastInsertID, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return r.rowsAffected, nil }
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+type stubResult struct{ affected int64 }
+
+func (r stubResult) LastInsertId() (int64, error) { return 0, nil }
+func (r stubResult) RowsAffected() (int64, error) { return r.affected, nil }
+
+func TestInterceptor_ObservesEveryQuery(t *testing.T) {
+	sqlDB, _ := newFakeDB(t)
+	ctx := context.Background()
+
+	var order []string
+	var seen []fluentsql.QueryInfo
+	outer := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+		order = append(order, "outer")
+		seen = append(seen, q)
+		return next(ctx, q)
+	}
+	inner := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+		order = append(order, "inner")
+		return next(ctx, q)
+	}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(outer), fluentsql.WithInterceptor(inner))
+
+	if _, err := db.Table("users").Where("password", "=", "hunter2").DeleteContext(ctx); err != nil {
+		t.Fatalf("DeleteContext() error = %v", err)
+	}
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		_, err := tx.ExecContext(ctx, "UPDATE counters SET n = n + 1")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	if want := []string{"outer", "inner", "outer", "inner", "outer", "inner", "outer", "inner"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("interceptor order = %v, want %v", order, want)
+	}
+	var ops []string
+	for _, q := range seen {
+		ops = append(ops, q.Operation)
+	}
+	if want := []string{"delete", "begin", "exec", "commit"}; !reflect.DeepEqual(ops, want) {
+		t.Fatalf("seen operations = %v, want %v", ops, want)
+	}
+
+	del := seen[0]
+	if del.Operation != "delete" || del.Method != fluentsql.MethodExec || del.Table != "users" || del.InTransaction || del.Dialect != "mysql" {
+		t.Errorf("delete info = %+v", del)
+	}
+	if want := []any{fluentsql.RedactedPlaceholder}; !reflect.DeepEqual(del.RedactedArgs(), want) {
+		t.Errorf("RedactedArgs() = %v, want %v", del.RedactedArgs(), want)
+	}
+
+	raw := seen[2]
+	if raw.Operation != "exec" || !raw.InTransaction || raw.Table != "" || raw.Tx == nil {
+		t.Errorf("raw transaction info = %+v", raw)
+	}
+	for _, q := range []fluentsql.QueryInfo{seen[1], seen[3]} {
+		if q.Method != fluentsql.MethodTx || q.SQL != "" || q.Tx != raw.Tx {
+			t.Errorf("%s lifecycle info = %+v", q.Operation, q)
+		}
+	}
+}
+
+func TestInterceptor_TransactionContext(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	ctx := context.Background()
+
+	type key struct{}
+	var commitCtx context.Context
+	scope := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+		switch q.Operation {
+		case "begin":
+			ctx = context.WithValue(ctx, key{}, "tx-scope")
+		case "commit":
+			commitCtx = ctx
+		}
+		return next(ctx, q)
+	}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(scope))
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() error = %v", err)
+	}
+	if got := tx.Context().Value(key{}); got != "tx-scope" {
+		t.Errorf("tx.Context() value = %v, want value set during begin", got)
+	}
+	if err := tx.Savepoint("sp1"); err != nil {
+		t.Fatalf("Savepoint() error = %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if commitCtx == nil || commitCtx.Value(key{}) != "tx-scope" {
+		t.Errorf("commit ran without the transaction context")
+	}
+	if want := []string{"BEGIN", "SAVEPOINT `sp1`", "COMMIT"}; !reflect.DeepEqual(fake.SQL(), want) {
+		t.Errorf("driver SQL = %v, want %v", fake.SQL(), want)
+	}
+}
+
+func TestInterceptor_ModifiesQuery(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	ctx := context.Background()
+
+	tag := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+		q.SQL = "/* app:billing */ " + q.SQL
+		return next(ctx, q)
+	}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(tag))
+
+	if _, err := db.Table("invoices").Where("id", "=", 3).CountContext(ctx); err != nil {
+		t.Fatalf("CountContext() error = %v", err)
+	}
+
+	got := fake.SQL()
+	if len(got) != 1 || !strings.HasPrefix(got[0], "/* app:billing */ SELECT COUNT(*)") {
+		t.Errorf("driver SQL = %v, want tagged count query", got)
+	}
+}
+
+func TestInterceptor_ShortCircuits(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	ctx := context.Background()
+
+	errReadOnly := errors.New("maintenance: writes disabled")
+	guard := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+		switch q.Operation {
+		case "delete":
+			return fluentsql.QueryOutcome{}, errReadOnly
+		case "update":
+			// Sürücüye gitmeden sahte sonuç dön
+			return fluentsql.QueryOutcome{Result: stubResult{affected: 5}}, nil
+		case "count":
+			// Sonuçsuz kısa devre bir hata olarak raporlanmalı
+			return fluentsql.QueryOutcome{}, nil
+		}
+		return next(ctx, q)
+	}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(guard))
+
+	_, err := db.Table("users").Where("id", "=", 1).DeleteContext(ctx)
+	if !errors.Is(err, errReadOnly) {
+		t.Errorf("DeleteContext() error = %v, want %v", err, errReadOnly)
+	}
+
+	res, err := db.Table("users").Where("id", "=", 1).UpdateContext(ctx, map[string]any{"name": "x"})
+	if err != nil {
+		t.Fatalf("UpdateContext() error = %v", err)
+	}
+	if n, _ := res.RowsAffected(); n != 5 {
+		t.Errorf("RowsAffected() = %d, want 5", n)
+	}
+
+	_, err = db.Table("users").CountContext(ctx)
+	if !errors.Is(err, fluentsql.ErrEmptyOutcome) {
+		t.Errorf("CountContext() error = %v, want ErrEmptyOutcome", err)
+	}
+
+	if len(fake.Queries()) != 0 {
+		t.Errorf("no SQL should reach the driver, got %v", fake.SQL())
+	}
+}
+
+func TestInterceptor_VetoesTransactionQueryRow(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	ctx := context.Background()
+
+	errVeto := errors.New("tenant guard: query rejected")
+	guard := func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+		if q.Method == fluentsql.MethodQueryRow {
+			return fluentsql.QueryOutcome{}, errVeto
+		}
+		return next(ctx, q)
+	}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithInterceptor(guard))
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() error = %v", err)
+	}
+	defer tx.Rollback()
+
+	var n int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&n); !errors.Is(err, errVeto) {
+		t.Errorf("Scan() error = %v, want the interceptor's error", err)
+	}
+	// Yer tutucu bir sorgu çalıştırılmamalı
+	if want := []string{"BEGIN"}; !reflect.DeepEqual(fake.SQL(), want) {
+		t.Errorf("driver SQL = %v, want %v", fake.SQL(), want)
+	}
+}
@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+// mysqlCatalog, information_schema sorgularına örnek bir "users" tablosu döndürür.
+func mysqlCatalog(query string, _ []any) fakeResponse {
+	switch {
+	case strings.Contains(query, "information_schema.columns"):
+		return fakeResponse{
+			Columns: []string{"COLUMN_NAME", "DATA_TYPE", "COLUMN_TYPE", "IS_NULLABLE", "COLUMN_DEFAULT", "COLUMN_KEY", "EXTRA", "COLUMN_COMMENT"},
+			Rows: [][]driver.Value{
+				{"id", "BIGINT", "bigint unsigned", "NO", nil, "PRI", "auto_increment", ""},
+				{"email", "varchar", "varchar(255)", "NO", nil, "UNI", "", "login"},
+				{"status", "varchar", "varchar(16)", "YES", "active", "", "", ""},
+			},
+		}
+	case strings.Contains(query, "information_schema.statistics"):
+		return fakeResponse{
+			Columns: []string{"INDEX_NAME", "COLUMN_NAME", "unique", "primary"},
+			Rows: [][]driver.Value{
+				{"PRIMARY", "id", int64(1), int64(1)},
+				{"users_email_unique", "email", int64(1), int64(0)},
+				{"users_status_email_index", "status", int64(0), int64(0)},
+				{"users_status_email_index", "email", int64(0), int64(0)},
+			},
+		}
+	case strings.Contains(query, "information_schema.key_column_usage"):
+		return fakeResponse{
+			Columns: []string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME", "DELETE_RULE", "UPDATE_RULE"},
+			Rows: [][]driver.Value{
+				{"users_team_foreign", "team_id", "teams", "id", "CASCADE", "NO ACTION"},
+			},
+		}
+	case strings.HasPrefix(query, "SELECT COUNT(*)"):
+		return fakeResponse{Columns: []string{"n"}, Rows: [][]driver.Value{{int64(1)}}}
+	case strings.Contains(query, "information_schema.tables"):
+		return fakeResponse{Columns: []string{"TABLE_NAME"}, Rows: [][]driver.Value{{"teams"}, {"users"}}}
+	}
+	return fakeResponse{}
+}
+
+func TestSchemaInspector_MySQL(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(mysqlCatalog)
+	s := fluentsql.NewDB(sqlDB, fluentsql.WithTablePrefix("app_")).Schema()
+	ctx := context.Background()
+
+	tables, err := s.Tables(ctx)
+	if err != nil || !reflect.DeepEqual(tables, []string{"teams", "users"}) {
+		t.Errorf("Tables() = %v, %v", tables, err)
+	}
+
+	cols, err := s.Columns(ctx, "users")
+	if err != nil {
+		t.Fatalf("Columns() error = %v", err)
+	}
+	active := "active"
+	want := []fluentsql.ColumnInfo{
+		{Name: "id", Type: "bigint", FullType: "bigint unsigned", PrimaryKey: true, AutoIncrement: true},
+		{Name: "email", Type: "varchar", FullType: "varchar(255)", Comment: "login"},
+		{Name: "status", Type: "varchar", FullType: "varchar(16)", Nullable: true, Default: &active},
+	}
+	if !reflect.DeepEqual(cols, want) {
+		t.Errorf("Columns() = %+v, want %+v", cols, want)
+	}
+	// Tablo adı fiziksel addır ve parametre olarak bağlanır; önek uygulanmaz
+	if q := fake.Queries()[1]; !reflect.DeepEqual(q.Args, []any{"users"}) {
+		t.Errorf("Columns() args = %v, want [users]", q.Args)
+	}
+
+	indexes, err := s.Indexes(ctx, "users")
+	if err != nil {
+		t.Fatalf("Indexes() error = %v", err)
+	}
+	wantIdx := []fluentsql.IndexInfo{
+		{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+		{Name: "users_email_unique", Columns: []string{"email"}, Unique: true},
+		{Name: "users_status_email_index", Columns: []string{"status", "email"}},
+	}
+	if !reflect.DeepEqual(indexes, wantIdx) {
+		t.Errorf("Indexes() = %+v, want %+v", indexes, wantIdx)
+	}
+
+	fks, err := s.ForeignKeys(ctx, "users")
+	wantFK := []fluentsql.ForeignKeyInfo{{
+		Name: "users_team_foreign", Columns: []string{"team_id"}, RefTable: "teams", RefColumns: []string{"id"},
+		OnDelete: "CASCADE", OnUpdate: "NO ACTION",
+	}}
+	if err != nil || !reflect.DeepEqual(fks, wantFK) {
+		t.Errorf("ForeignKeys() = %+v, %v, want %+v", fks, err, wantFK)
+	}
+
+	if ok, err := s.HasTable(ctx, "users"); !ok || err != nil {
+		t.Errorf("HasTable() = %v, %v", ok, err)
+	}
+	if ok, err := s.HasColumn(ctx, "users", "EMAIL"); !ok || err != nil {
+		t.Errorf("HasColumn(EMAIL) = %v, %v", ok, err)
+	}
+	if ok, _ := s.HasColumn(ctx, "users", "phone"); ok {
+		t.Error("HasColumn(phone) = true")
+	}
+}
+
+func TestSchemaInspector_SQLite(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(func(query string, args []any) fakeResponse {
+		switch {
+		case strings.Contains(query, "pragma_table_info"):
+			return fakeResponse{
+				Columns: []string{"name", "type", "notnull", "dflt_value", "pk"},
+				Rows: [][]driver.Value{
+					{"id", "INTEGER", int64(0), nil, int64(1)},
+					{"team_id", "INTEGER", int64(0), nil, int64(0)},
+					{"name", "VARCHAR(100)", int64(1), "'x'", int64(0)},
+				},
+			}
+		case strings.Contains(query, "pragma_index_list"):
+			return fakeResponse{Columns: []string{"name", "unique", "origin"}, Rows: [][]driver.Value{{"users_name_index", int64(0), "c"}}}
+		case strings.Contains(query, "pragma_index_info"):
+			return fakeResponse{Columns: []string{"name"}, Rows: [][]driver.Value{{"name"}}}
+		case strings.Contains(query, "pragma_foreign_key_list"):
+			return fakeResponse{
+				Columns: []string{"id", "from", "table", "to", "on_delete", "on_update"},
+				Rows:    [][]driver.Value{{int64(0), "team_id", "teams", nil, "SET NULL", "NO ACTION"}},
+			}
+		}
+		return fakeResponse{}
+	})
+	s := fluentsql.NewDB(sqlDB).Schema().WithDialect("sqlite3")
+	ctx := context.Background()
+
+	if got := s.Dialect(); got != "sqlite" {
+		t.Errorf("Dialect() = %q, want sqlite", got)
+	}
+
+	cols, err := s.Columns(ctx, "users")
+	if err != nil {
+		t.Fatalf("Columns() error = %v", err)
+	}
+	def := "'x'"
+	want := []fluentsql.ColumnInfo{
+		{Name: "id", Type: "integer", FullType: "integer", PrimaryKey: true, AutoIncrement: true},
+		{Name: "team_id", Type: "integer", FullType: "integer", Nullable: true},
+		{Name: "name", Type: "varchar", FullType: "varchar(100)", Default: &def},
+	}
+	if !reflect.DeepEqual(cols, want) {
+		t.Errorf("Columns() = %+v, want %+v", cols, want)
+	}
+
+	indexes, err := s.Indexes(ctx, "users")
+	wantIdx := []fluentsql.IndexInfo{
+		{Name: "primary", Columns: []string{"id"}, Unique: true, Primary: true},
+		{Name: "users_name_index", Columns: []string{"name"}},
+	}
+	if err != nil || !reflect.DeepEqual(indexes, wantIdx) {
+		t.Errorf("Indexes() = %+v, %v, want rowid primary key reported", indexes, err)
+	}
+
+	// "REFERENCES teams" hedef kolonu vermez; hedefin primary key'i kullanılır
+	fks, err := s.ForeignKeys(ctx, "users")
+	wantFK := []fluentsql.ForeignKeyInfo{{
+		Columns: []string{"team_id"}, RefTable: "teams", RefColumns: []string{"id"}, OnDelete: "SET NULL", OnUpdate: "NO ACTION",
+	}}
+	if err != nil || !reflect.DeepEqual(fks, wantFK) {
+		t.Errorf("ForeignKeys() = %+v, %v, want %+v", fks, err, wantFK)
+	}
+}
+
+func TestSchemaInspector_Errors(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	if _, err := db.Schema().WithDialect("oracle").Tables(ctx); !errors.Is(err, fluentsql.ErrUnsupportedDialect) {
+		t.Errorf("Tables() error = %v, want ErrUnsupportedDialect", err)
+	}
+
+	boom := errors.New("access denied")
+	fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: boom} })
+	_, err := db.Schema().Columns(ctx, "users")
+	var qe *fluentsql.QueryError
+	if !errors.As(err, &qe) || !errors.Is(err, boom) || qe.Table != "users" {
+		t.Errorf("Columns() error = %v, want QueryError wrapping the driver error", err)
+	}
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+// captureLogger, Logger'a ulaşan kayıtları testte incelemek için saklar.
+type captureLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+type logEntry struct {
+	SQL  string
+	Args []any
+	Err  error
+}
+
+func (l *captureLogger) Log(query string, args []any, _ time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, logEntry{SQL: query, Args: args, Err: err})
+}
+
+func (l *captureLogger) Entries() []logEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]logEntry(nil), l.entries...)
+}
+
+func TestLogging_DebugReportsEveryQuery(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	logger := &captureLogger{}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithDebug(true), fluentsql.WithLogger(logger))
+	ctx := context.Background()
+
+	if _, err := db.Table("users").Where("id", "=", 1).UpdateContext(ctx, map[string]any{
+		"name":          "Ahmet",
+		"password_hash": "s3cr3t",
+	}); err != nil {
+		t.Fatalf("UpdateContext() error = %v", err)
+	}
+	if _, err := db.Table("users").Where("email", "=", "a@b.c").
+		Where("reset_token", "=", "tok-123").
+		DeleteContext(ctx); err != nil {
+		t.Fatalf("DeleteContext() error = %v", err)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+
+	wantUpdate := []any{"Ahmet", fluentsql.RedactedPlaceholder, 1}
+	if !reflect.DeepEqual(entries[0].Args, wantUpdate) {
+		t.Errorf("update log args = %v, want %v", entries[0].Args, wantUpdate)
+	}
+	wantDelete := []any{"a@b.c", fluentsql.RedactedPlaceholder}
+	if !reflect.DeepEqual(entries[1].Args, wantDelete) {
+		t.Errorf("delete log args = %v, want %v", entries[1].Args, wantDelete)
+	}
+
+	// Sürücü maskelenmiş değil, ham değeri almalı
+	queries := fake.Queries()
+	if got := queries[0].Args[1]; got != "s3cr3t" {
+		t.Errorf("driver received %v for password_hash, want raw value", got)
+	}
+	if got := queries[1].Args[1]; got != "tok-123" {
+		t.Errorf("driver received %v for reset_token, want raw value", got)
+	}
+}
+
+func TestLogging_ErrorsOnlyWithoutDebug(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	logger := &captureLogger{}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithLogger(logger))
+	ctx := context.Background()
+
+	if _, err := db.Table("users").InsertContext(ctx, map[string]any{"name": "ok"}); err != nil {
+		t.Fatalf("InsertContext() error = %v", err)
+	}
+
+	boom := errors.New("duplicate entry")
+	fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: boom} })
+
+	_, err := db.Table("users").InsertContext(ctx, map[string]any{
+		"name":    "dup",
+		"api_key": fluentsql.Sensitive("k-1"),
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("InsertContext() error = %v, want %v", err, boom)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("logged %d entries, want only the failed query", len(entries))
+	}
+	if !errors.Is(entries[0].Err, boom) {
+		t.Errorf("logged error = %v, want %v", entries[0].Err, boom)
+	}
+	if want := []any{fluentsql.RedactedPlaceholder, "dup"}; !reflect.DeepEqual(entries[0].Args, want) {
+		t.Errorf("logged args = %v, want %v", entries[0].Args, want)
+	}
+}
+
+func TestLogging_TransactionRawQueries(t *testing.T) {
+	sqlDB, _ := newFakeDB(t)
+	logger := &captureLogger{}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithDebug(true), fluentsql.WithLogger(logger))
+	ctx := context.Background()
+
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		if _, err := tx.ExecContext(ctx, "UPDATE sessions SET token = ?", fluentsql.Sensitive("abc")); err != nil {
+			return err
+		}
+		_, err := tx.Table("users").Where("id", "=", 7).IncrementContext(ctx, "logins", 1, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+	if want := []any{fluentsql.RedactedPlaceholder}; !reflect.DeepEqual(entries[0].Args, want) {
+		t.Errorf("raw exec log args = %v, want %v", entries[0].Args, want)
+	}
+	if want := "UPDATE `users` SET `logins` = `logins` + ? WHERE `id` = ?"; entries[1].SQL != want {
+		t.Errorf("builder log SQL = %q, want %q", entries[1].SQL, want)
+	}
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := fluentsql.NewStdLogger(log.New(&buf, "", 0))
+
+	logger.Log("SELECT * FROM `users` WHERE `id` = ?", []any{42}, time.Millisecond, nil)
+	logger.Log("DELETE FROM `users`", nil, time.Millisecond, errors.New("boom"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2: %q", len(lines), buf.String())
+	}
+	if want := "[fluentsql] 1ms SELECT * FROM `users` WHERE `id` = ? [42]"; lines[0] != want {
+		t.Errorf("line 1 = %q, want %q", lines[0], want)
+	}
+	if !strings.HasSuffix(lines[1], "error=boom") {
+		t.Errorf("line 2 = %q, want error suffix", lines[1])
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+type observation struct {
+	Operation, Table string
+	Err              error
+}
+
+// captureRecorder, MetricsRecorder'a ulaşan gözlemleri saklar.
+type captureRecorder struct {
+	mu   sync.Mutex
+	seen []observation
+}
+
+func (r *captureRecorder) ObserveQuery(operation, table string, _ time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seen = append(r.seen, observation{operation, table, err})
+}
+
+func TestMetrics_ObservesQueries(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	rec := &captureRecorder{}
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithMetrics(rec))
+	ctx := context.Background()
+
+	if _, err := db.Table("users").InsertContext(ctx, map[string]any{"name": "a"}); err != nil {
+		t.Fatalf("InsertContext() error = %v", err)
+	}
+
+	boom := errors.New("deadlock found")
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		if query == "BEGIN" || query == "ROLLBACK" {
+			return fakeResponse{}
+		}
+		return fakeResponse{Err: boom}
+	})
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		_, err := tx.Table("orders").Where("id", "=", 1).DeleteContext(ctx)
+		return err
+	})
+
+	var qe *fluentsql.QueryError
+	if !errors.As(err, &qe) || qe.Class() != fluentsql.ErrorClassDriver {
+		t.Fatalf("Transaction() error = %v, want driver-class QueryError", err)
+	}
+	// BEGIN ve ROLLBACK sorgu olarak sayılmaz
+	if len(rec.seen) != 2 || rec.seen[1].Operation != "delete" || rec.seen[1].Table != "orders" || !errors.Is(rec.seen[1].Err, boom) {
+		t.Errorf("observations = %+v, want failed delete on orders", rec.seen)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{nil, fluentsql.ErrorClassNone},
+		{sql.ErrNoRows, fluentsql.ErrorClassNoRows},
+		{fmt.Errorf("wrapped: %w", context.DeadlineExceeded), fluentsql.ErrorClassTimeout},
+		{context.Canceled, fluentsql.ErrorClassCanceled},
+		{sql.ErrConnDone, fluentsql.ErrorClassConnection},
+		{fluentsql.ErrTxAlreadyClosed, fluentsql.ErrorClassTxClosed},
+		{fluentsql.NewValidationError("identifier", "a b", "invalid"), fluentsql.ErrorClassValidation},
+		{dialect.ErrNoTable, fluentsql.ErrorClassCompile},
+		{fluentsql.NewQueryError("select", "users", "", errors.New("Error 1146")), fluentsql.ErrorClassDriver},
+	}
+	for _, tt := range tests {
+		if got := fluentsql.ErrorClass(tt.err); got != tt.want {
+			t.Errorf("ErrorClass(%v) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
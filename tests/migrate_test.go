@@ -0,0 +1,246 @@
+package tests
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+	"github.com/biyonik/go-fluent-sql/migrate"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+// migrationHistory, sahte sürücünün lock ve history sorgularına vereceği
+// yanıtları kurar.
+func migrationHistory(fake *fakeDB, locked bool, history ...[]driver.Value) {
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		switch {
+		case strings.HasPrefix(query, "SELECT GET_LOCK"):
+			if locked {
+				return fakeResponse{Columns: []string{"l"}, Rows: [][]driver.Value{{int64(0)}}}
+			}
+			return fakeResponse{Columns: []string{"l"}, Rows: [][]driver.Value{{int64(1)}}}
+		case strings.HasPrefix(query, "SELECT pg_try_advisory_lock"):
+			return fakeResponse{Columns: []string{"l"}, Rows: [][]driver.Value{{!locked}}}
+		case strings.HasPrefix(query, "SELECT migration, batch, checksum"):
+			return fakeResponse{Columns: []string{"migration", "batch", "checksum"}, Rows: history}
+		}
+		return fakeResponse{RowsAffected: 1}
+	})
+}
+
+func execMigration(sql string) migrate.Func {
+	return func(ctx context.Context, s *migrate.Session) error {
+		return s.Exec(ctx, sql)
+	}
+}
+
+func TestMigrate_Up(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	m := migrate.New(fluentsql.NewDB(sqlDB))
+
+	files, err := migrate.FromFS(fstest.MapFS{
+		"migrations/003_seed.up.sql":   {Data: []byte("INSERT INTO roles (name) VALUES ('a;b');\n-- trailing comment\nINSERT INTO roles (name) VALUES ('c');\n")},
+		"migrations/003_seed.down.sql": {Data: []byte("DELETE FROM roles;")},
+	}, "migrations")
+	if err != nil {
+		t.Fatalf("FromFS() error = %v", err)
+	}
+	if err := m.Add(append(files,
+		migrate.Migration{Name: "001_users", Up: execMigration("CREATE TABLE users (id INT)")},
+		migrate.Migration{Name: "002_roles", Up: execMigration("CREATE TABLE roles (name TEXT)")},
+	)...); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	migrationHistory(fake, false, []driver.Value{"001_users", int64(1), nil})
+
+	ran, err := m.Up(context.Background())
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if want := []string{"002_roles", "003_seed"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("Up() ran %v, want %v", ran, want)
+	}
+
+	queries := fake.Queries()
+	got := make([]string, len(queries))
+	for i, q := range queries {
+		got[i] = q.SQL
+	}
+	if !strings.HasPrefix(got[0], "SELECT GET_LOCK") || !strings.HasPrefix(got[len(got)-1], "SELECT RELEASE_LOCK") {
+		t.Errorf("statements are not wrapped in the advisory lock: %q", got)
+	}
+	if !strings.HasPrefix(got[1], "CREATE TABLE IF NOT EXISTS `migrations`") {
+		t.Errorf("history table statement = %q", got[1])
+	}
+	want := []string{
+		"CREATE TABLE roles (name TEXT)",
+		"INSERT INTO `migrations` (migration, batch, checksum) VALUES (?, ?, ?)",
+		"INSERT INTO roles (name) VALUES ('a;b')",
+		"-- trailing comment\nINSERT INTO roles (name) VALUES ('c')",
+		"INSERT INTO `migrations` (migration, batch, checksum) VALUES (?, ?, ?)",
+	}
+	if !reflect.DeepEqual(got[3:len(got)-1], want) {
+		t.Errorf("statements = %q, want %q", got[3:len(got)-1], want)
+	}
+	// MySQL'de DDL transaction'a alınmaz; yeni batch 2'dir
+	if insert := queries[4].Args; insert[0] != "002_roles" || insert[1] != int64(2) || insert[2] != nil {
+		t.Errorf("history insert args = %v", insert)
+	}
+	if checksum := queries[7].Args[2]; checksum != files[0].Checksum {
+		t.Errorf("SQL migration checksum = %v, want %s", checksum, files[0].Checksum)
+	}
+}
+
+func TestMigrate_RollbackInTransaction(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	m := migrate.New(fluentsql.NewDB(sqlDB), migrate.WithGrammar(schema.Postgres()))
+	if err := m.Add(
+		migrate.Migration{Name: "001_users", Up: execMigration("up 1"), Down: execMigration("down 1")},
+		migrate.Migration{Name: "002_roles", Up: execMigration("up 2"), Down: execMigration("down 2")},
+		migrate.Migration{Name: "003_teams", Up: execMigration("up 3"), Down: execMigration("down 3")},
+	); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	migrationHistory(fake, false,
+		[]driver.Value{"001_users", int64(1), nil},
+		[]driver.Value{"002_roles", int64(2), nil},
+		[]driver.Value{"003_teams", int64(2), nil},
+	)
+	ctx := context.Background()
+
+	ran, err := m.Down(ctx)
+	if err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if want := []string{"003_teams", "002_roles"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("Down() rolled back %v, want last batch %v", ran, want)
+	}
+	got := fake.SQL()
+	want := []string{
+		"BEGIN", "down 3", `DELETE FROM "migrations" WHERE migration = $1`, "COMMIT",
+		"BEGIN", "down 2", `DELETE FROM "migrations" WHERE migration = $1`, "COMMIT",
+		"SELECT pg_advisory_unlock($1)",
+	}
+	if !reflect.DeepEqual(got[3:], want) {
+		t.Errorf("statements = %q, want %q", got[3:], want)
+	}
+
+	ran, err = m.Rollback(ctx, 1)
+	if err != nil || !reflect.DeepEqual(ran, []string{"003_teams"}) {
+		t.Errorf("Rollback(1) = %v, %v, want [003_teams]", ran, err)
+	}
+}
+
+func TestMigrate_TablePrefix(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithTablePrefix("acme_"), fluentsql.WithGrammar(dialect.SQLite()))
+	m := migrate.New(db)
+	if err := m.Add(migrate.Migration{Name: "001_users", Up: execMigration("up 1")}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		switch {
+		case strings.HasPrefix(query, "SELECT name FROM sqlite_master"):
+			return fakeResponse{Columns: []string{"name"}, Rows: [][]driver.Value{
+				{"acme_migrations"}, {"acme_users"}, {"beta_migrations"}, {"beta_users"}, {"tenants"},
+			}}
+		case strings.HasPrefix(query, "SELECT migration, batch, checksum"):
+			return fakeResponse{Columns: []string{"migration", "batch", "checksum"}}
+		}
+		return fakeResponse{RowsAffected: 1}
+	})
+
+	if _, err := m.Fresh(context.Background()); err != nil {
+		t.Fatalf("Fresh() error = %v", err)
+	}
+	var drops []string
+	var history string
+	for _, q := range fake.SQL() {
+		switch {
+		case strings.HasPrefix(q, "DROP TABLE"):
+			drops = append(drops, q)
+		case strings.HasPrefix(q, "CREATE TABLE IF NOT EXISTS"):
+			history = q
+		}
+	}
+	want := []string{`DROP TABLE IF EXISTS "acme_migrations"`, `DROP TABLE IF EXISTS "acme_users"`}
+	if !reflect.DeepEqual(drops, want) {
+		t.Errorf("Fresh() dropped %q, want %q", drops, want)
+	}
+	if !strings.HasPrefix(history, `CREATE TABLE IF NOT EXISTS "acme_migrations"`) {
+		t.Errorf("history table statement = %q", history)
+	}
+}
+
+func TestMigrate_Guards(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	m := migrate.New(fluentsql.NewDB(sqlDB))
+	ctx := context.Background()
+	seed := migrate.SQL("001_seed", "INSERT INTO roles VALUES (1)", "")
+	if err := m.Add(seed); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := m.Add(seed); !errors.Is(err, migrate.ErrDuplicateMigration) {
+		t.Errorf("Add() duplicate error = %v", err)
+	}
+
+	migrationHistory(fake, true)
+	if _, err := m.Up(ctx); !errors.Is(err, migrate.ErrLocked) {
+		t.Fatalf("Up() error = %v, want ErrLocked", err)
+	}
+	if got := fake.SQL(); len(got) != 1 {
+		t.Errorf("ran %q while the lock was held elsewhere", got)
+	}
+
+	migrationHistory(fake, false,
+		[]driver.Value{"001_seed", int64(1), "0000"},
+		[]driver.Value{"000_legacy", int64(1), nil},
+	)
+	if _, err := m.Up(ctx); !errors.Is(err, migrate.ErrChecksumMismatch) {
+		t.Errorf("Up() error = %v, want ErrChecksumMismatch", err)
+	}
+	if _, err := m.Rollback(ctx, 1); !errors.Is(err, migrate.ErrMissingMigration) {
+		t.Errorf("Rollback() error = %v, want ErrMissingMigration", err)
+	}
+	if _, err := m.Rollback(ctx, 2); !errors.Is(err, migrate.ErrMissingMigration) {
+		t.Errorf("Rollback(2) error = %v, want ErrMissingMigration for the newest record first", err)
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	want := []migrate.Status{
+		{Name: "000_legacy", Applied: true, Batch: 1, Missing: true},
+		{Name: "001_seed", Applied: true, Batch: 1, Modified: true},
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Status() = %+v, want %+v", status, want)
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	src := `
+-- create things; not a separator
+CREATE TABLE "a;b" (x TEXT DEFAULT 'it''s; fine');
+/* block; comment */
+CREATE FUNCTION f() RETURNS trigger AS $body$ BEGIN RETURN NEW; END; $body$ LANGUAGE plpgsql;
+SELECT $1;
+-- only a comment
+`
+	got := migrate.SplitStatements(src)
+	want := []string{
+		"-- create things; not a separator\nCREATE TABLE \"a;b\" (x TEXT DEFAULT 'it''s; fine')",
+		"/* block; comment */\nCREATE FUNCTION f() RETURNS trigger AS $body$ BEGIN RETURN NEW; END; $body$ LANGUAGE plpgsql",
+		"SELECT $1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitStatements() =\n%q\nwant\n%q", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -20,6 +21,8 @@ type mockBuilder struct {
 	having     []dialect.WhereClause
 	limit      *int
 	offset     *int
+	lock       *dialect.LockClause
+	returning  []string
 }
 
 func (m *mockBuilder) GetTable() string                 { return m.table }
@@ -33,6 +36,8 @@ func (m *mockBuilder) GetGroupBy() []string             { return m.groupBy }
 func (m *mockBuilder) GetHaving() []dialect.WhereClause { return m.having }
 func (m *mockBuilder) GetLimit() *int                   { return m.limit }
 func (m *mockBuilder) GetOffset() *int                  { return m.offset }
+func (m *mockBuilder) GetReturning() []string           { return m.returning }
+func (m *mockBuilder) GetLock() *dialect.LockClause     { return m.lock }
 
 func intPtr(n int) *int { return &n }
 
@@ -728,7 +733,400 @@ func TestMySQLGrammar_CompileUpsert(t *testing.T) {
 	}
 }
 
+func TestMySQLGrammar_FullText(t *testing.T) {
+	g := dialect.MySQL()
+
+	search := func(mode dialect.FullTextMode) *dialect.FullTextClause {
+		return &dialect.FullTextClause{
+			Columns: []string{"name", "description"},
+			Query:   "wireless",
+			Options: dialect.FullTextOptions{Mode: mode},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		builder  *mockBuilder
+		wantSQL  string
+		wantArgs []any
+		wantErr  bool
+	}{
+		{
+			name: "boolean mode by default",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search("")},
+				},
+			},
+			wantSQL:  "SELECT * FROM `products` WHERE MATCH(`name`, `description`) AGAINST(? IN BOOLEAN MODE)",
+			wantArgs: []any{"wireless"},
+		},
+		{
+			name: "natural language mode",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search(dialect.FullTextNatural)},
+				},
+			},
+			wantSQL:  "SELECT * FROM `products` WHERE MATCH(`name`, `description`) AGAINST(? IN NATURAL LANGUAGE MODE)",
+			wantArgs: []any{"wireless"},
+		},
+		{
+			name: "query expansion",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search(dialect.FullTextExpansion)},
+				},
+			},
+			wantSQL:  "SELECT * FROM `products` WHERE MATCH(`name`, `description`) AGAINST(? WITH QUERY EXPANSION)",
+			wantArgs: []any{"wireless"},
+		},
+		{
+			name: "order by relevance binds after where",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "status", Operator: "=", Value: "active"},
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search("")},
+				},
+				orders: []dialect.OrderClause{
+					{Direction: dialect.OrderDesc, FullText: search("")},
+				},
+			},
+			wantSQL:  "SELECT * FROM `products` WHERE `status` = ? AND MATCH(`name`, `description`) AGAINST(? IN BOOLEAN MODE) ORDER BY MATCH(`name`, `description`) AGAINST(? IN BOOLEAN MODE) DESC",
+			wantArgs: []any{"active", "wireless", "wireless"},
+		},
+		{
+			name: "invalid mode",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search("FUZZY")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no columns",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: &dialect.FullTextClause{Query: "wireless"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "malicious column",
+			builder: &mockBuilder{
+				table: "products",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: &dialect.FullTextClause{
+						Columns: []string{"name) AGAINST('x') OR 1=1 -- "},
+						Query:   "wireless",
+					}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotArgs, err := g.CompileSelect(tt.builder)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompileSelect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if gotSQL != tt.wantSQL {
+					t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, tt.wantSQL)
+				}
+				if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+					t.Errorf("CompileSelect() args = %v, want %v", gotArgs, tt.wantArgs)
+				}
+			}
+		})
+	}
+}
+
+func TestMySQLGrammar_Lock(t *testing.T) {
+	g := dialect.MySQL()
+
+	tests := []struct {
+		name    string
+		lock    *dialect.LockClause
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			name:    "for update",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 FOR UPDATE",
+		},
+		{
+			name:    "for share",
+			lock:    &dialect.LockClause{Strength: dialect.LockShare},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 FOR SHARE",
+		},
+		{
+			name:    "skip locked",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate, Wait: dialect.LockSkipLocked},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 FOR UPDATE SKIP LOCKED",
+		},
+		{
+			name:    "nowait with of",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate, Wait: dialect.LockNoWait, Of: []string{"jobs"}},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 FOR UPDATE OF `jobs` NOWAIT",
+		},
+		{
+			name:    "invalid strength",
+			lock:    &dialect.LockClause{Strength: "EXCLUSIVE"},
+			wantErr: true,
+		},
+		{
+			name:    "malicious of table",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate, Of: []string{"jobs; DROP TABLE jobs"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &mockBuilder{table: "jobs", limit: intPtr(10), lock: tt.lock}
+			gotSQL, _, err := g.CompileSelect(builder)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompileSelect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && gotSQL != tt.wantSQL {
+				t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, tt.wantSQL)
+			}
+		})
+	}
+}
+
+func TestMariaDBGrammar_Lock(t *testing.T) {
+	g := dialect.MariaDB()
+
+	tests := []struct {
+		name    string
+		lock    *dialect.LockClause
+		wantSQL string
+		wantErr error
+	}{
+		{
+			name:    "for update",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 FOR UPDATE",
+		},
+		{
+			name:    "shared lock",
+			lock:    &dialect.LockClause{Strength: dialect.LockShare},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 LOCK IN SHARE MODE",
+		},
+		{
+			name:    "skip locked",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate, Wait: dialect.LockSkipLocked},
+			wantErr: dialect.ErrUnsupportedFeature,
+		},
+		{
+			name:    "nowait",
+			lock:    &dialect.LockClause{Strength: dialect.LockShare, Wait: dialect.LockNoWait},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 LOCK IN SHARE MODE NOWAIT",
+		},
+		{
+			name:    "for update nowait",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate, Wait: dialect.LockNoWait},
+			wantSQL: "SELECT * FROM `jobs` LIMIT 10 FOR UPDATE NOWAIT",
+		},
+		{
+			name:    "of",
+			lock:    &dialect.LockClause{Strength: dialect.LockUpdate, Of: []string{"jobs"}},
+			wantErr: dialect.ErrUnsupportedFeature,
+		},
+		{
+			name:    "invalid strength",
+			lock:    &dialect.LockClause{Strength: "EXCLUSIVE"},
+			wantErr: dialect.ErrInvalidLock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &mockBuilder{table: "jobs", limit: intPtr(10), lock: tt.lock}
+			gotSQL, _, err := g.CompileSelect(builder)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CompileSelect() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && gotSQL != tt.wantSQL {
+				t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, tt.wantSQL)
+			}
+		})
+	}
+}
+
+func TestMySQLGrammar_JoinedWrites(t *testing.T) {
+	g := dialect.MySQL()
+	join := dialect.JoinClause{Type: dialect.JoinInner, Table: "orders", First: "orders.user_id", Operator: "=", Second: "users.id"}
+	wheres := []dialect.WhereClause{
+		{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "orders.status", Operator: "=", Value: "refunded"},
+	}
+
+	t.Run("update join", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}, wheres: wheres}
+		gotSQL, gotArgs, err := g.CompileUpdate(builder, map[string]any{"users.flagged": true})
+		if err != nil {
+			t.Fatalf("CompileUpdate() error = %v", err)
+		}
+		wantSQL := "UPDATE `users` INNER JOIN `orders` ON `orders`.`user_id` = `users`.`id` SET `users`.`flagged` = ? WHERE `orders`.`status` = ?"
+		if gotSQL != wantSQL {
+			t.Errorf("CompileUpdate() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+		if !reflect.DeepEqual(gotArgs, []any{true, "refunded"}) {
+			t.Errorf("CompileUpdate() args = %v", gotArgs)
+		}
+	})
+
+	t.Run("delete join", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}, wheres: wheres}
+		gotSQL, _, err := g.CompileDelete(builder)
+		if err != nil {
+			t.Fatalf("CompileDelete() error = %v", err)
+		}
+		wantSQL := "DELETE `users` FROM `users` INNER JOIN `orders` ON `orders`.`user_id` = `users`.`id` WHERE `orders`.`status` = ?"
+		if gotSQL != wantSQL {
+			t.Errorf("CompileDelete() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+	})
+
+	t.Run("delete join with alias", func(t *testing.T) {
+		aliased := dialect.JoinClause{Type: dialect.JoinLeft, Table: "orders", First: "orders.user_id", Operator: "=", Second: "u.id"}
+		builder := &mockBuilder{table: "users as u", joins: []dialect.JoinClause{aliased}}
+		gotSQL, _, err := g.CompileDelete(builder)
+		if err != nil {
+			t.Fatalf("CompileDelete() error = %v", err)
+		}
+		wantSQL := "DELETE `u` FROM `users` AS `u` LEFT JOIN `orders` ON `orders`.`user_id` = `u`.`id`"
+		if gotSQL != wantSQL {
+			t.Errorf("CompileDelete() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+	})
+
+	t.Run("count join", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}, wheres: wheres}
+		gotSQL, _, err := g.CompileCount(builder, "")
+		if err != nil {
+			t.Fatalf("CompileCount() error = %v", err)
+		}
+		wantSQL := "SELECT COUNT(*) FROM `users` INNER JOIN `orders` ON `orders`.`user_id` = `users`.`id` WHERE `orders`.`status` = ?"
+		if gotSQL != wantSQL {
+			t.Errorf("CompileCount() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+	})
+
+	t.Run("truncate with join is rejected", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}}
+		if _, err := g.CompileTruncate(builder); !errors.Is(err, dialect.ErrJoinNotSupported) {
+			t.Errorf("CompileTruncate() error = %v, want ErrJoinNotSupported", err)
+		}
+	})
+
+	t.Run("subquery join requires alias", func(t *testing.T) {
+		sub := &mockBuilder{table: "orders"}
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{
+			{Type: dialect.JoinInner, Subquery: sub, First: "o.user_id", Operator: "=", Second: "users.id"},
+		}}
+		if _, _, err := g.CompileSelect(builder); !errors.Is(err, dialect.ErrSubqueryAlias) {
+			t.Errorf("CompileSelect() error = %v, want ErrSubqueryAlias", err)
+		}
+	})
+}
+
+func TestMySQLGrammar_Returning(t *testing.T) {
+	data := map[string]any{"name": "Ahmet"}
+	wheres := []dialect.WhereClause{
+		{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "id", Operator: "=", Value: 1},
+	}
+
+	t.Run("mariadb insert", func(t *testing.T) {
+		g := dialect.MariaDB()
+		if !g.SupportsReturning() {
+			t.Fatal("MariaDB().SupportsReturning() = false, want true")
+		}
+		builder := &mockBuilder{table: "users", returning: []string{"id", "uuid"}}
+		gotSQL, _, err := g.CompileInsert(builder, data)
+		if err != nil {
+			t.Fatalf("CompileInsert() error = %v", err)
+		}
+		if want := "INSERT INTO `users` (`name`) VALUES (?) RETURNING `id`, `uuid`"; gotSQL != want {
+			t.Errorf("CompileInsert() SQL = %q, want %q", gotSQL, want)
+		}
+	})
+
+	t.Run("mariadb upsert", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", returning: []string{"*"}}
+		gotSQL, _, err := dialect.MariaDB().CompileUpsert(builder, data, nil)
+		if err != nil {
+			t.Fatalf("CompileUpsert() error = %v", err)
+		}
+		if want := "INSERT INTO `users` (`name`) VALUES (?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`) RETURNING *"; gotSQL != want {
+			t.Errorf("CompileUpsert() SQL = %q, want %q", gotSQL, want)
+		}
+	})
+
+	t.Run("mariadb delete", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", wheres: wheres, returning: []string{"id"}}
+		gotSQL, _, err := dialect.MariaDB().CompileDelete(builder)
+		if err != nil {
+			t.Fatalf("CompileDelete() error = %v", err)
+		}
+		if want := "DELETE FROM `users` WHERE `id` = ? RETURNING `id`"; gotSQL != want {
+			t.Errorf("CompileDelete() SQL = %q, want %q", gotSQL, want)
+		}
+	})
+
+	t.Run("mariadb update is rejected", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", wheres: wheres, returning: []string{"id"}}
+		if _, _, err := dialect.MariaDB().CompileUpdate(builder, data); !errors.Is(err, dialect.ErrReturningNotSupported) {
+			t.Errorf("CompileUpdate() error = %v, want ErrReturningNotSupported", err)
+		}
+	})
+
+	t.Run("mysql is rejected", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", returning: []string{"id"}}
+		if _, _, err := dialect.MySQL().CompileInsert(builder, data); !errors.Is(err, dialect.ErrReturningNotSupported) {
+			t.Errorf("CompileInsert() error = %v, want ErrReturningNotSupported", err)
+		}
+	})
+}
+
 // Benchmark tests
+func TestMySQLGrammar_Savepoints(t *testing.T) {
+	g := dialect.MySQL()
+
+	compile := map[string]func(string) (string, error){
+		"SAVEPOINT `sp_1`":             g.CompileSavepoint,
+		"ROLLBACK TO SAVEPOINT `sp_1`": g.CompileRollbackToSavepoint,
+		"RELEASE SAVEPOINT `sp_1`":     g.CompileReleaseSavepoint,
+	}
+	for want, fn := range compile {
+		got, err := fn("sp_1")
+		if err != nil || got != want {
+			t.Errorf("got %q, %v; want %q", got, err, want)
+		}
+	}
+
+	for _, name := range []string{"", "*", "a.b", "sp; DROP TABLE users", "sp`x"} {
+		if sql, err := g.CompileSavepoint(name); err == nil {
+			t.Errorf("CompileSavepoint(%q) = %q, want error", name, sql)
+		}
+	}
+}
+
 func BenchmarkMySQLGrammar_CompileSelect(b *testing.B) {
 	g := dialect.MySQL()
 	builder := &mockBuilder{
@@ -0,0 +1,298 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+func TestPostgresGrammar_Name(t *testing.T) {
+	g := dialect.Postgres()
+	if g.Name() != "postgres" {
+		t.Errorf("Name() = %q, want %q", g.Name(), "postgres")
+	}
+	if g.Placeholder(0) != "$1" || g.Placeholder(2) != "$3" {
+		t.Errorf("Placeholder() = %q, %q", g.Placeholder(0), g.Placeholder(2))
+	}
+}
+
+func TestPostgresGrammar_CompileSelect(t *testing.T) {
+	g := dialect.Postgres()
+
+	tests := []struct {
+		name     string
+		builder  *mockBuilder
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name: "numbered placeholders",
+			builder: &mockBuilder{
+				table:   "users",
+				columns: []string{"id", "users.email"},
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "status", Operator: "=", Value: "active"},
+					{Type: dialect.WhereTypeIn, Boolean: dialect.WhereBooleanAnd, Column: "role", Values: []any{"admin", "editor"}},
+				},
+				limit:  intPtr(10),
+				offset: intPtr(20),
+			},
+			wantSQL:  `SELECT "id", "users"."email" FROM "users" WHERE "status" = $1 AND "role" IN ($2, $3) LIMIT 10 OFFSET 20`,
+			wantArgs: []any{"active", "admin", "editor"},
+		},
+		{
+			name: "question marks inside literals are kept",
+			builder: &mockBuilder{
+				table: "posts",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeRaw, Boolean: dialect.WhereBooleanAnd, Raw: "title <> 'why?' AND views > ?", Bindings: []any{5}},
+					{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "author_id", Operator: "=", Value: 7},
+				},
+			},
+			wantSQL:  `SELECT * FROM "posts" WHERE title <> 'why?' AND views > $1 AND "author_id" = $2`,
+			wantArgs: []any{5, 7},
+		},
+		{
+			name: "date parts",
+			builder: &mockBuilder{
+				table: "orders",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeDate, Boolean: dialect.WhereBooleanAnd, Column: "created_at", Value: "2024-01-15"},
+					{Type: dialect.WhereTypeYear, Boolean: dialect.WhereBooleanAnd, Column: "created_at", Value: 2024},
+				},
+			},
+			wantSQL:  `SELECT * FROM "orders" WHERE CAST("created_at" AS DATE) = $1 AND EXTRACT(YEAR FROM "created_at") = $2`,
+			wantArgs: []any{"2024-01-15", 2024},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotArgs, err := g.CompileSelect(tt.builder)
+			if err != nil {
+				t.Fatalf("CompileSelect() error = %v", err)
+			}
+			if gotSQL != tt.wantSQL {
+				t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("CompileSelect() args = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPostgresGrammar_CompileWrites(t *testing.T) {
+	g := dialect.Postgres()
+	wheres := []dialect.WhereClause{
+		{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "id", Operator: "=", Value: 1},
+	}
+
+	gotSQL, gotArgs, err := g.CompileUpdate(&mockBuilder{table: "users", wheres: wheres}, map[string]any{"name": "John", "age": 30})
+	if err != nil {
+		t.Fatalf("CompileUpdate() error = %v", err)
+	}
+	if want := `UPDATE "users" SET "age" = $1, "name" = $2 WHERE "id" = $3`; gotSQL != want {
+		t.Errorf("CompileUpdate() SQL = %q, want %q", gotSQL, want)
+	}
+	if !reflect.DeepEqual(gotArgs, []any{30, "John", 1}) {
+		t.Errorf("CompileUpdate() args = %v", gotArgs)
+	}
+
+	gotSQL, _, err = g.CompileInsertBatch(&mockBuilder{table: "users"}, []map[string]any{{"name": "a"}, {"name": "b"}})
+	if err != nil {
+		t.Fatalf("CompileInsertBatch() error = %v", err)
+	}
+	if want := `INSERT INTO "users" ("name") VALUES ($1), ($2)`; gotSQL != want {
+		t.Errorf("CompileInsertBatch() SQL = %q, want %q", gotSQL, want)
+	}
+
+	if _, _, err := g.CompileUpsert(&mockBuilder{table: "users"}, map[string]any{"name": "a"}, nil); !errors.Is(err, dialect.ErrUnsupportedFeature) {
+		t.Errorf("CompileUpsert() error = %v, want ErrUnsupportedFeature", err)
+	}
+}
+
+func TestPostgresGrammar_FullText(t *testing.T) {
+	g := dialect.Postgres()
+
+	search := func(mode dialect.FullTextMode, columns ...string) *dialect.FullTextClause {
+		return &dialect.FullTextClause{
+			Columns: columns,
+			Query:   "wireless",
+			Options: dialect.FullTextOptions{Mode: mode},
+		}
+	}
+
+	t.Run("where and relevance", func(t *testing.T) {
+		builder := &mockBuilder{
+			table: "products",
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search("", "name", "description")},
+			},
+			orders: []dialect.OrderClause{
+				{Direction: dialect.OrderDesc, FullText: search("", "name", "description")},
+			},
+		}
+		gotSQL, gotArgs, err := g.CompileSelect(builder)
+		if err != nil {
+			t.Fatalf("CompileSelect() error = %v", err)
+		}
+		doc := `to_tsvector(coalesce("name", '') || ' ' || coalesce("description", ''))`
+		wantSQL := `SELECT * FROM "products" WHERE ` + doc + ` @@ plainto_tsquery($1) ORDER BY ts_rank(` + doc + `, plainto_tsquery($2)) DESC`
+		if gotSQL != wantSQL {
+			t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+		if !reflect.DeepEqual(gotArgs, []any{"wireless", "wireless"}) {
+			t.Errorf("CompileSelect() args = %v", gotArgs)
+		}
+	})
+
+	t.Run("single column", func(t *testing.T) {
+		builder := &mockBuilder{
+			table: "products",
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search(dialect.FullTextNatural, "name")},
+			},
+		}
+		gotSQL, _, err := g.CompileSelect(builder)
+		if err != nil {
+			t.Fatalf("CompileSelect() error = %v", err)
+		}
+		if want := `SELECT * FROM "products" WHERE to_tsvector("name") @@ plainto_tsquery($1)`; gotSQL != want {
+			t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, want)
+		}
+	})
+
+	t.Run("query expansion is unsupported", func(t *testing.T) {
+		builder := &mockBuilder{
+			table: "products",
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search(dialect.FullTextExpansion, "name")},
+			},
+		}
+		if _, _, err := g.CompileSelect(builder); !errors.Is(err, dialect.ErrUnsupportedFeature) {
+			t.Errorf("CompileSelect() error = %v, want ErrUnsupportedFeature", err)
+		}
+	})
+}
+
+func TestPostgresGrammar_JoinedWrites(t *testing.T) {
+	g := dialect.Postgres()
+	join := dialect.JoinClause{Type: dialect.JoinInner, Table: "orders", First: "orders.user_id", Operator: "=", Second: "users.id"}
+	wheres := []dialect.WhereClause{
+		{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "orders.status", Operator: "=", Value: "refunded"},
+		{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanOr, Column: "orders.status", Operator: "=", Value: "disputed"},
+	}
+
+	t.Run("update from", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}, wheres: wheres}
+		gotSQL, gotArgs, err := g.CompileUpdate(builder, map[string]any{"users.flagged": true})
+		if err != nil {
+			t.Fatalf("CompileUpdate() error = %v", err)
+		}
+		wantSQL := `UPDATE "users" SET "flagged" = $1 FROM "orders" WHERE "orders"."user_id" = "users"."id" AND ("orders"."status" = $2 OR "orders"."status" = $3)`
+		if gotSQL != wantSQL {
+			t.Errorf("CompileUpdate() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+		if !reflect.DeepEqual(gotArgs, []any{true, "refunded", "disputed"}) {
+			t.Errorf("CompileUpdate() args = %v", gotArgs)
+		}
+	})
+
+	t.Run("update from subquery binds in text order", func(t *testing.T) {
+		sub := &mockBuilder{
+			table:   "orders",
+			columns: []string{"user_id"},
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "total", Operator: ">", Value: 100},
+			},
+		}
+		builder := &mockBuilder{
+			table: "users",
+			joins: []dialect.JoinClause{{Type: dialect.JoinInner, Alias: "t", Subquery: sub, First: "t.user_id", Operator: "=", Second: "users.id"}},
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "users.active", Operator: "=", Value: true},
+			},
+		}
+		gotSQL, gotArgs, err := g.CompileUpdate(builder, map[string]any{"tier": "gold"})
+		if err != nil {
+			t.Fatalf("CompileUpdate() error = %v", err)
+		}
+		wantSQL := `UPDATE "users" SET "tier" = $1 FROM (SELECT "user_id" FROM "orders" WHERE "total" > $2) AS "t" WHERE "t"."user_id" = "users"."id" AND ("users"."active" = $3)`
+		if gotSQL != wantSQL {
+			t.Errorf("CompileUpdate() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+		if !reflect.DeepEqual(gotArgs, []any{"gold", 100, true}) {
+			t.Errorf("CompileUpdate() args = %v", gotArgs)
+		}
+	})
+
+	t.Run("delete using", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}, wheres: wheres[:1]}
+		gotSQL, _, err := g.CompileDelete(builder)
+		if err != nil {
+			t.Fatalf("CompileDelete() error = %v", err)
+		}
+		wantSQL := `DELETE FROM "users" USING "orders" WHERE "orders"."user_id" = "users"."id" AND ("orders"."status" = $1)`
+		if gotSQL != wantSQL {
+			t.Errorf("CompileDelete() SQL = %q, want %q", gotSQL, wantSQL)
+		}
+	})
+
+	t.Run("left join is rejected", func(t *testing.T) {
+		left := join
+		left.Type = dialect.JoinLeft
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{left}}
+		if _, _, err := g.CompileDelete(builder); !errors.Is(err, dialect.ErrJoinNotSupported) {
+			t.Errorf("CompileDelete() error = %v, want ErrJoinNotSupported", err)
+		}
+	})
+
+	t.Run("setting a joined table column is rejected", func(t *testing.T) {
+		builder := &mockBuilder{table: "users", joins: []dialect.JoinClause{join}}
+		if _, _, err := g.CompileUpdate(builder, map[string]any{"orders.note": "x"}); !errors.Is(err, dialect.ErrUnsupportedFeature) {
+			t.Errorf("CompileUpdate() error = %v, want ErrUnsupportedFeature", err)
+		}
+	})
+}
+
+func TestPostgresGrammar_Lock(t *testing.T) {
+	g := dialect.Postgres()
+	builder := &mockBuilder{
+		table: "jobs",
+		wheres: []dialect.WhereClause{
+			{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "status", Operator: "=", Value: "pending"},
+		},
+		limit: intPtr(10),
+		lock:  &dialect.LockClause{Strength: dialect.LockUpdate, Wait: dialect.LockSkipLocked, Of: []string{"jobs"}},
+	}
+	gotSQL, _, err := g.CompileSelect(builder)
+	if err != nil {
+		t.Fatalf("CompileSelect() error = %v", err)
+	}
+	if want := `SELECT * FROM "jobs" WHERE "status" = $1 LIMIT 10 FOR UPDATE OF "jobs" SKIP LOCKED`; gotSQL != want {
+		t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, want)
+	}
+}
+
+func TestPostgresGrammar_ClassifyError(t *testing.T) {
+	g := dialect.Postgres()
+
+	got := g.ClassifyError(dialect.ErrorCode{
+		SQLState: "23505",
+		Message:  `duplicate key value violates unique constraint "users_email_key"`,
+	})
+	if got.Kind != dialect.ErrorKindUniqueViolation || got.Constraint != "users_email_key" {
+		t.Errorf("ClassifyError() = %+v", got)
+	}
+
+	got = g.ClassifyError(dialect.ErrorCode{
+		SQLState: "23502",
+		Message:  `null value in column "email" of relation "users" violates not-null constraint`,
+	})
+	if got.Kind != dialect.ErrorKindNotNullViolation || got.Column != "email" {
+		t.Errorf("ClassifyError() = %+v", got)
+	}
+}
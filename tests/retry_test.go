@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+// mysqlError, go-sql-driver/mysql'in *MySQLError tipinin alan yapısını taklit eder.
+type mysqlError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (e *mysqlError) Error() string {
+	return fmt.Sprintf("Error %d (%s): %s", e.Number, e.SQLState[:], e.Message)
+}
+
+// pgError, lib/pq ve pgx hata tiplerindeki Code alanını taklit eder.
+type pgError struct {
+	Code string
+}
+
+func (e *pgError) Error() string { return "pq: error " + e.Code }
+
+var errDeadlock = &mysqlError{Number: 1213, SQLState: [5]byte{'4', '0', '0', '0', '1'}, Message: "Deadlock found when trying to get lock"}
+
+func fastRetry() fluentsql.RetryPolicy {
+	return fluentsql.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errDeadlock, true},
+		{&mysqlError{Number: 1205, Message: "Lock wait timeout exceeded"}, true},
+		{&mysqlError{Number: 1062, Message: "Duplicate entry"}, false},
+		{&pgError{Code: "40P01"}, true},
+		{&pgError{Code: "40001"}, true},
+		{&pgError{Code: "23505"}, false},
+		{fluentsql.NewQueryError("update", "stock", "", errDeadlock), true},
+		{errors.New("ERROR: could not serialize access (SQLSTATE 40001)"), true},
+		{errors.New("Error 1213 (40001): Deadlock found"), true},
+		{&mysqlError{Number: 1927, Message: "Connection was killed"}, false},
+		{&pgError{Code: "08006"}, false},
+		{&mysqlError{Number: 3572, Message: "NOWAIT is set"}, true},
+		{context.DeadlineExceeded, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := fluentsql.IsTransient(tt.err); got != tt.want {
+			t.Errorf("IsTransient(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestTransactionWithRetry(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	calls := 0
+	err := db.TransactionWithRetry(ctx, fastRetry(), func(tx *fluentsql.Transaction) error {
+		calls++
+		if calls < 3 {
+			return fluentsql.NewQueryError("update", "stock", "", errDeadlock)
+		}
+		_, err := tx.ExecContext(ctx, "UPDATE stock SET qty = qty - 1")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("TransactionWithRetry() error = %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+	want := "BEGIN ROLLBACK BEGIN ROLLBACK BEGIN UPDATE stock SET qty = qty - 1 COMMIT"
+	if got := strings.Join(fake.SQL(), " "); got != want {
+		t.Errorf("driver SQL = %q, want %q", got, want)
+	}
+
+	// Kalıcı hatalar yeniden denenmez
+	calls = 0
+	boom := errors.New("validation failed")
+	err = db.TransactionWithRetry(ctx, fastRetry(), func(*fluentsql.Transaction) error {
+		calls++
+		return boom
+	})
+	if !errors.Is(err, boom) || calls != 1 {
+		t.Errorf("permanent error: calls = %d, err = %v", calls, err)
+	}
+}
+
+func TestTransactionWithRetry_HonoursContext(t *testing.T) {
+	sqlDB, _ := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := fluentsql.RetryPolicy{MaxAttempts: 10, BaseDelay: time.Hour}
+
+	calls := 0
+	err := db.TransactionWithRetry(ctx, policy, func(*fluentsql.Transaction) error {
+		calls++
+		cancel()
+		return errDeadlock
+	})
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, errDeadlock) || calls != 1 {
+		t.Errorf("calls = %d, err = %v; want one attempt ending with the context error", calls, err)
+	}
+}
+
+func TestRetry_StandaloneWrites(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithRetryPolicy(fastRetry()))
+	ctx := context.Background()
+
+	attempts := 0
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		if strings.HasPrefix(query, "UPDATE") {
+			if attempts++; attempts < 2 {
+				return fakeResponse{Err: errDeadlock}
+			}
+		}
+		return fakeResponse{RowsAffected: 1}
+	})
+
+	if _, err := db.Table("counters").Where("id", "=", 1).IncrementContext(ctx, "hits", 1, nil); err != nil {
+		t.Fatalf("IncrementContext() error = %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("update attempted %d times, want 2", attempts)
+	}
+
+	// DB.Transaction varsayılan politikayı kullanır; transaction içindeki ifade ayrıca tekrarlanmaz
+	attempts = 0
+	calls := 0
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		calls++
+		_, err := tx.Table("counters").Where("id", "=", 1).IncrementContext(ctx, "hits", 1, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+	if calls != 2 || attempts != 2 {
+		t.Errorf("fn calls = %d, statement attempts = %d; want 2 and 2", calls, attempts)
+	}
+
+	// Builder.Retry ile tekrar kapatılabilir
+	attempts = 0
+	_, err = db.Table("counters").Where("id", "=", 1).Retry(fluentsql.RetryPolicy{}).IncrementContext(ctx, "hits", 1, nil)
+	if !errors.Is(err, errDeadlock) || attempts != 1 {
+		t.Errorf("Retry(zero) attempts = %d, err = %v", attempts, err)
+	}
+}
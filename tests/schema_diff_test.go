@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/dialect"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+type diffUser struct {
+	ID       int64          `db:"id,pk"`
+	Email    *string        `db:"email"`
+	Status   *string        `db:"status"`
+	Nickname sql.NullString `db:"nickname"`
+	Secret   string         `db:"-"`
+}
+
+func (diffUser) TableName() string { return "users" }
+
+func TestSchemaDiff_Blueprint(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(mysqlCatalog)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	bp := schema.NewBlueprint("users", "")
+	bp.ID()
+	bp.String("email", 255).Unique()
+	bp.String("status", 32).Nullable()
+	bp.String("phone", 32).Nullable().Index()
+
+	d, err := schema.New(db).Diff(ctx, db.Schema(), bp, schema.DiffOptions{})
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	want := []string{
+		"ALTER TABLE `users` MODIFY COLUMN `status` VARCHAR(32) NULL DEFAULT 'active'",
+		"ALTER TABLE `users` ADD COLUMN `phone` VARCHAR(32) NULL",
+		"ALTER TABLE `users` ADD KEY `users_phone_index` (`phone`)",
+	}
+	if !reflect.DeepEqual(d.Statements, want) {
+		t.Errorf("Statements = %q, want %q", d.Statements, want)
+	}
+	// Canlı şemada olup tanımda olmayan index yalnızca raporlanır
+	skipped := []string{"ALTER TABLE `users` DROP INDEX `users_status_email_index`"}
+	if !reflect.DeepEqual(d.Skipped, skipped) {
+		t.Errorf("Skipped = %q, want %q", d.Skipped, skipped)
+	}
+	if d.Create || d.Empty() {
+		t.Errorf("Create = %v, Empty() = %v", d.Create, d.Empty())
+	}
+
+	d, err = schema.New(db).Diff(ctx, db.Schema(), bp, schema.DiffOptions{AllowDrops: true})
+	if err != nil || len(d.Skipped) != 0 || d.Statements[len(d.Statements)-1] != skipped[0] {
+		t.Errorf("Diff(AllowDrops) = %q, %q, %v", d.Statements, d.Skipped, err)
+	}
+}
+
+func TestSchemaDiff_LossyChanges(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(mysqlCatalog)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	bp := schema.NewBlueprint("users", "")
+	bp.ID()
+	bp.String("email", 100).Unique()
+	bp.Integer("status").Nullable()
+	bp.Index("status", "email")
+
+	d, err := schema.New(db).Diff(ctx, db.Schema(), bp, schema.DiffOptions{})
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	// VARCHAR(255) → VARCHAR(100) daraltır, VARCHAR → INT tür ailesini değiştirir
+	lossy := []string{
+		"ALTER TABLE `users` MODIFY COLUMN `email` VARCHAR(100) NOT NULL COMMENT 'login'",
+		"ALTER TABLE `users` MODIFY COLUMN `status` INT NULL DEFAULT 'active'",
+	}
+	if len(d.Statements) != 0 || !reflect.DeepEqual(d.Skipped, lossy) {
+		t.Errorf("Diff() = %q, skipped %q, want only skipped %q", d.Statements, d.Skipped, lossy)
+	}
+
+	d, err = schema.New(db).Diff(ctx, db.Schema(), bp, schema.DiffOptions{AllowLossyChanges: true})
+	if err != nil || !reflect.DeepEqual(d.Statements, lossy) || len(d.Skipped) != 0 {
+		t.Errorf("Diff(AllowLossyChanges) = %q, %q, %v", d.Statements, d.Skipped, err)
+	}
+}
+
+func TestSchemaDiff_SQLiteUnsupportedChange(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		switch {
+		case strings.HasPrefix(query, "SELECT COUNT(*)"):
+			return fakeResponse{Columns: []string{"n"}, Rows: [][]driver.Value{{int64(1)}}}
+		case strings.Contains(query, "pragma_table_info"):
+			return fakeResponse{
+				Columns: []string{"name", "type", "notnull", "dflt_value", "pk"},
+				Rows: [][]driver.Value{
+					{"id", "INTEGER", int64(1), nil, int64(1)},
+					{"name", "VARCHAR(100)", int64(1), nil, int64(0)},
+					{"bio", "VARCHAR(100)", int64(1), nil, int64(0)},
+				},
+			}
+		}
+		return fakeResponse{}
+	})
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithGrammar(dialect.SQLite()))
+
+	bp := schema.NewBlueprint("users", "")
+	bp.ID()
+	bp.String("name", 100).Nullable()
+	bp.String("bio", 50)
+	bp.String("phone", 20).Nullable()
+
+	d, err := schema.New(db).Diff(context.Background(), db.Schema(), bp, schema.DiffOptions{AllowLossyChanges: true})
+	if err != nil {
+		t.Fatalf("Diff() error = %v, want unsupported changes reported", err)
+	}
+	if want := []string{`ALTER TABLE "users" ADD COLUMN "phone" VARCHAR(20)`}; !reflect.DeepEqual(d.Statements, want) {
+		t.Errorf("Statements = %q, want %q", d.Statements, want)
+	}
+	if want := []string{"name", "bio"}; !reflect.DeepEqual(d.Unsupported, want) || len(d.Skipped) != 0 {
+		t.Errorf("Unsupported = %q, Skipped = %q, want %q", d.Unsupported, d.Skipped, want)
+	}
+}
+
+func TestSchemaDiff_FromStruct(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(mysqlCatalog)
+	db := fluentsql.NewDB(sqlDB)
+
+	bp, err := schema.FromStruct("", "", &diffUser{})
+	if err != nil {
+		t.Fatalf("FromStruct() error = %v", err)
+	}
+	if bp.Table() != "users" {
+		t.Errorf("Table() = %q, want users from TableName()", bp.Table())
+	}
+
+	d, err := schema.New(db).Diff(context.Background(), db.Schema(), bp, schema.DiffOptions{})
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	// Yalnızca NULL'luğu değişen sütun canlı türünü ve yorumunu korur; struct'lar
+	// index taşımadığından index silme önerilmez.
+	want := []string{
+		"ALTER TABLE `users` MODIFY COLUMN `email` VARCHAR(255) NULL COMMENT 'login'",
+		"ALTER TABLE `users` ADD COLUMN `nickname` VARCHAR(255) NULL",
+	}
+	if !reflect.DeepEqual(d.Statements, want) || len(d.Skipped) != 0 {
+		t.Errorf("Diff() = %q, skipped %q, want %q", d.Statements, d.Skipped, want)
+	}
+}
+
+func TestSchemaDiff_CreateMissingTable(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		if strings.HasPrefix(query, "SELECT COUNT(*)") {
+			return fakeResponse{Columns: []string{"n"}, Rows: [][]driver.Value{{int64(0)}}}
+		}
+		return fakeResponse{}
+	})
+	db := fluentsql.NewDB(sqlDB)
+
+	bp, err := schema.FromStruct("accounts", "app_", diffUser{})
+	if err != nil {
+		t.Fatalf("FromStruct() error = %v", err)
+	}
+	d, err := schema.New(db).Diff(context.Background(), db.Schema(), bp, schema.DiffOptions{})
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	want := "CREATE TABLE `app_accounts` (`id` BIGINT NOT NULL AUTO_INCREMENT, `email` VARCHAR(255) NULL, " +
+		"`status` VARCHAR(255) NULL, `nickname` VARCHAR(255) NULL, PRIMARY KEY (`id`))"
+	if !d.Create || len(d.Statements) != 1 || d.Statements[0] != want {
+		t.Errorf("Diff() = %v %q, want %q", d.Create, d.Statements, want)
+	}
+}
+
+func TestSchemaDiff_FromStructErrors(t *testing.T) {
+	type bad struct {
+		Tags []string `db:"tags"`
+	}
+	if _, err := schema.FromStruct("t", "", bad{}); !errors.Is(err, fluentsql.ErrInvalidValue) {
+		t.Errorf("FromStruct(unsupported type) error = %v, want ErrInvalidValue", err)
+	}
+	if _, err := schema.FromStruct("", "", struct{ ID int64 }{}); !errors.Is(err, fluentsql.ErrInvalidValue) {
+		t.Errorf("FromStruct(no TableName) error = %v, want ErrInvalidValue", err)
+	}
+	if _, err := schema.FromStruct("t", "", 42); !errors.Is(err, fluentsql.ErrInvalidValue) {
+		t.Errorf("FromStruct(non-struct) error = %v, want ErrInvalidValue", err)
+	}
+}
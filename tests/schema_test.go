@@ -0,0 +1,227 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+	"github.com/biyonik/go-fluent-sql/schema"
+)
+
+func usersBlueprint(t *schema.Blueprint) {
+	t.ID()
+	t.String("email", 255).Unique()
+	t.Boolean("active").Default(true)
+	t.ForeignID("team_id").Constrained("teams").OnDelete(schema.Cascade)
+	t.Timestamps()
+}
+
+func TestSchema_CreateTable(t *testing.T) {
+	tests := []struct {
+		grammar schema.Grammar
+		want    []string
+	}{
+		{schema.MySQL(), []string{
+			"CREATE TABLE `users` (`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT, `email` VARCHAR(255) NOT NULL, " +
+				"`active` TINYINT(1) NOT NULL DEFAULT 1, `team_id` BIGINT UNSIGNED NOT NULL, " +
+				"`created_at` TIMESTAMP NULL, `updated_at` TIMESTAMP NULL, PRIMARY KEY (`id`), " +
+				"UNIQUE KEY `users_email_unique` (`email`), CONSTRAINT `users_team_id_foreign` FOREIGN KEY (`team_id`) " +
+				"REFERENCES `teams` (`id`) ON DELETE CASCADE)",
+		}},
+		{schema.Postgres(), []string{
+			`CREATE TABLE "users" ("id" BIGSERIAL NOT NULL, "email" VARCHAR(255) NOT NULL, ` +
+				`"active" BOOLEAN NOT NULL DEFAULT TRUE, "team_id" BIGINT NOT NULL, ` +
+				`"created_at" TIMESTAMP, "updated_at" TIMESTAMP, PRIMARY KEY ("id"), ` +
+				`CONSTRAINT "users_team_id_foreign" FOREIGN KEY ("team_id") REFERENCES "teams" ("id") ON DELETE CASCADE)`,
+			`CREATE UNIQUE INDEX "users_email_unique" ON "users" ("email")`,
+		}},
+		{schema.SQLite(), []string{
+			`CREATE TABLE "users" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "email" VARCHAR(255) NOT NULL, ` +
+				`"active" INTEGER NOT NULL DEFAULT 1, "team_id" INTEGER NOT NULL, ` +
+				`"created_at" DATETIME, "updated_at" DATETIME, ` +
+				`CONSTRAINT "users_team_id_foreign" FOREIGN KEY ("team_id") REFERENCES "teams" ("id") ON DELETE CASCADE)`,
+			`CREATE UNIQUE INDEX "users_email_unique" ON "users" ("email")`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.grammar.Name(), func(t *testing.T) {
+			got, err := schema.NewBuilder(nil, tt.grammar).CreateSQL("users", usersBlueprint)
+			if err != nil {
+				t.Fatalf("CreateSQL() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateSQL() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchema_AlterTable(t *testing.T) {
+	alter := func(t *schema.Blueprint) {
+		t.String("phone", 32).Nullable().After("email")
+		t.Integer("age").Default(0).Change()
+		t.RenameColumn("name", "full_name")
+		t.DropColumn("legacy")
+		t.Index("phone", "age").Name("users_contact_idx")
+		t.DropForeign("users_team_id_foreign")
+	}
+
+	got, err := schema.NewBuilder(nil, schema.MySQL()).TableSQL("users", alter)
+	if err != nil {
+		t.Fatalf("MySQL TableSQL() error = %v", err)
+	}
+	want := []string{
+		"ALTER TABLE `users` ADD COLUMN `phone` VARCHAR(32) NULL AFTER `email`",
+		"ALTER TABLE `users` MODIFY COLUMN `age` INT NOT NULL DEFAULT 0",
+		"ALTER TABLE `users` RENAME COLUMN `name` TO `full_name`",
+		"ALTER TABLE `users` DROP COLUMN `legacy`",
+		"ALTER TABLE `users` ADD KEY `users_contact_idx` (`phone`, `age`)",
+		"ALTER TABLE `users` DROP FOREIGN KEY `users_team_id_foreign`",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MySQL TableSQL() =\n%q\nwant\n%q", got, want)
+	}
+
+	got, err = schema.NewBuilder(nil, schema.Postgres()).TableSQL("users", alter)
+	if err != nil {
+		t.Fatalf("Postgres TableSQL() error = %v", err)
+	}
+	want = []string{
+		`ALTER TABLE "users" ADD COLUMN "phone" VARCHAR(32)`,
+		`ALTER TABLE "users" ALTER COLUMN "age" TYPE INTEGER, ALTER COLUMN "age" SET NOT NULL, ALTER COLUMN "age" SET DEFAULT 0`,
+		`ALTER TABLE "users" RENAME COLUMN "name" TO "full_name"`,
+		`ALTER TABLE "users" DROP COLUMN "legacy"`,
+		`CREATE INDEX "users_contact_idx" ON "users" ("phone", "age")`,
+		`ALTER TABLE "users" DROP CONSTRAINT "users_team_id_foreign"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Postgres TableSQL() =\n%q\nwant\n%q", got, want)
+	}
+
+	// SQLite sütun değiştirmeyi ve foreign key ALTER'ını desteklemez
+	_, err = schema.NewBuilder(nil, schema.SQLite()).TableSQL("users", alter)
+	if !errors.Is(err, schema.ErrNotSupported) {
+		t.Errorf("SQLite TableSQL() error = %v, want ErrNotSupported", err)
+	}
+}
+
+func TestSchema_DropAndRename(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB, fluentsql.WithTablePrefix("app_"))
+	s := schema.New(db)
+	ctx := context.Background()
+
+	if err := s.DropIfExists(ctx, "users"); err != nil {
+		t.Fatalf("DropIfExists() error = %v", err)
+	}
+	if err := s.Rename(ctx, "users", "members"); err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+	want := []string{"DROP TABLE IF EXISTS `app_users`", "RENAME TABLE `app_users` TO `app_members`"}
+	if got := fake.SQL(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SQL = %q, want %q", got, want)
+	}
+
+	pg, _ := schema.Postgres().CompileRename("public.users", "members")
+	if pg != `ALTER TABLE "public"."users" RENAME TO "members"` {
+		t.Errorf("Postgres CompileRename() = %q", pg)
+	}
+}
+
+func TestSchema_InterceptorsAndUnprefixedTables(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	var seen []string
+	db := fluentsql.NewDB(sqlDB,
+		fluentsql.WithTablePrefix("app_"),
+		fluentsql.WithUnprefixedTables("tenants"),
+		fluentsql.WithInterceptor(func(ctx context.Context, q fluentsql.QueryInfo, next fluentsql.QueryHandler) (fluentsql.QueryOutcome, error) {
+			seen = append(seen, q.Operation+" "+q.Table)
+			return next(ctx, q)
+		}),
+	)
+	s := schema.New(db)
+	ctx := context.Background()
+
+	err := s.Create(ctx, "posts", func(t *schema.Blueprint) {
+		t.ID()
+		t.ForeignID("tenant_id").Constrained("tenants")
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := s.DropIfExists(ctx, "tenants"); err != nil {
+		t.Fatalf("DropIfExists() error = %v", err)
+	}
+
+	want := []string{
+		"CREATE TABLE `app_posts` (`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT, `tenant_id` BIGINT UNSIGNED NOT NULL, " +
+			"PRIMARY KEY (`id`), CONSTRAINT `app_posts_tenant_id_foreign` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`))",
+		"DROP TABLE IF EXISTS `tenants`",
+	}
+	if got := fake.SQL(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SQL = %q, want %q", got, want)
+	}
+	if wantSeen := []string{"create_table posts", "drop_table tenants"}; !reflect.DeepEqual(seen, wantSeen) {
+		t.Errorf("interceptor saw %q, want %q", seen, wantSeen)
+	}
+}
+
+func TestSchema_Create_ExecutesStatements(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	s := schema.NewBuilder(sqlDB, schema.Postgres())
+	ctx := context.Background()
+
+	if err := s.Create(ctx, "users", usersBlueprint); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if got := fake.SQL(); len(got) != 2 {
+		t.Fatalf("executed %q, want CREATE TABLE and CREATE INDEX", got)
+	}
+
+	boom := errors.New("relation already exists")
+	fake.OnQuery(func(string, []any) fakeResponse { return fakeResponse{Err: boom} })
+	err := s.Create(ctx, "users", usersBlueprint)
+	var qe *fluentsql.QueryError
+	if !errors.As(err, &qe) || qe.Op != "create_table" || !errors.Is(err, boom) || qe.SQL == "" {
+		t.Errorf("Create() error = %v, want create_table QueryError wrapping driver error", err)
+	}
+}
+
+func TestSchema_Validation(t *testing.T) {
+	s := schema.NewBuilder(nil, schema.MySQL())
+
+	_, err := s.CreateSQL("users", func(t *schema.Blueprint) {
+		t.String("email; DROP TABLE users")
+	})
+	if !errors.Is(err, fluentsql.ErrInvalidIdentifier) {
+		t.Errorf("invalid column error = %v, want ErrInvalidIdentifier", err)
+	}
+
+	_, err = s.CreateSQL("posts", func(t *schema.Blueprint) {
+		t.ForeignID("user_id").Constrained().OnDelete("CASCADE; DROP TABLE users")
+	})
+	if !errors.Is(err, fluentsql.ErrInvalidValue) {
+		t.Errorf("invalid action error = %v, want ErrInvalidValue", err)
+	}
+
+	got, err := s.CreateSQL("notes", func(t *schema.Blueprint) {
+		t.String("body").Default("it's a \\ test")
+		t.Enum("status", "draft", "published").Default("draft")
+		t.Engine("InnoDB")
+	})
+	if err != nil {
+		t.Fatalf("CreateSQL() error = %v", err)
+	}
+	want := "CREATE TABLE `notes` (`body` VARCHAR(255) NOT NULL DEFAULT 'it''s a \\\\ test', " +
+		"`status` ENUM('draft', 'published') NOT NULL DEFAULT 'draft') ENGINE=InnoDB"
+	if got[0] != want {
+		t.Errorf("CreateSQL() = %q, want %q", got[0], want)
+	}
+
+	if _, err := schema.GrammarFor("oracle"); !errors.Is(err, schema.ErrUnsupportedDialect) {
+		t.Errorf("GrammarFor(oracle) error = %v, want ErrUnsupportedDialect", err)
+	}
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/biyonik/go-fluent-sql/dialect"
+)
+
+func TestSQLiteGrammar_CompileSelect(t *testing.T) {
+	g := dialect.SQLite()
+
+	tests := []struct {
+		name     string
+		builder  *mockBuilder
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name: "double quoted identifiers",
+			builder: &mockBuilder{
+				table:   "users as u",
+				columns: []string{"u.id"},
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeBasic, Boolean: dialect.WhereBooleanAnd, Column: "u.status", Operator: "=", Value: "active"},
+				},
+			},
+			wantSQL:  `SELECT "u"."id" FROM "users" AS "u" WHERE "u"."status" = ?`,
+			wantArgs: []any{"active"},
+		},
+		{
+			name:     "offset without limit",
+			builder:  &mockBuilder{table: "users", offset: intPtr(20)},
+			wantSQL:  `SELECT * FROM "users" LIMIT -1 OFFSET 20`,
+			wantArgs: []any{},
+		},
+		{
+			name: "date parts",
+			builder: &mockBuilder{
+				table: "orders",
+				wheres: []dialect.WhereClause{
+					{Type: dialect.WhereTypeDate, Boolean: dialect.WhereBooleanAnd, Column: "created_at", Value: "2024-01-15"},
+					{Type: dialect.WhereTypeMonth, Boolean: dialect.WhereBooleanAnd, Column: "created_at", Value: 12},
+				},
+			},
+			wantSQL:  `SELECT * FROM "orders" WHERE date("created_at") = ? AND CAST(strftime('%m', "created_at") AS INTEGER) = ?`,
+			wantArgs: []any{"2024-01-15", 12},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotArgs, err := g.CompileSelect(tt.builder)
+			if err != nil {
+				t.Fatalf("CompileSelect() error = %v", err)
+			}
+			if gotSQL != tt.wantSQL {
+				t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("CompileSelect() args = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestSQLiteGrammar_CompileWrites(t *testing.T) {
+	g := dialect.SQLite()
+
+	gotSQL, err := g.CompileTruncate(&mockBuilder{table: "sessions"})
+	if err != nil {
+		t.Fatalf("CompileTruncate() error = %v", err)
+	}
+	if want := `DELETE FROM "sessions"`; gotSQL != want {
+		t.Errorf("CompileTruncate() SQL = %q, want %q", gotSQL, want)
+	}
+
+	join := dialect.JoinClause{Type: dialect.JoinInner, Table: "orders as o", First: "o.user_id", Operator: "=", Second: "u.id"}
+	gotSQL, gotArgs, err := g.CompileUpdate(&mockBuilder{table: "users as u", joins: []dialect.JoinClause{join}}, map[string]any{"u.flagged": 1})
+	if err != nil {
+		t.Fatalf("CompileUpdate() error = %v", err)
+	}
+	if want := `UPDATE "users" AS "u" SET "flagged" = ? FROM "orders" AS "o" WHERE "o"."user_id" = "u"."id"`; gotSQL != want {
+		t.Errorf("CompileUpdate() SQL = %q, want %q", gotSQL, want)
+	}
+	if _, _, err := g.CompileDelete(&mockBuilder{table: "users as u", joins: []dialect.JoinClause{join}}); !errors.Is(err, dialect.ErrJoinNotSupported) {
+		t.Errorf("CompileDelete() error = %v, want ErrJoinNotSupported", err)
+	}
+
+	gotSQL, gotArgs, err = g.CompileUpsert(&mockBuilder{table: "users"}, map[string]any{"email": "a@b.c", "name": "A"}, []string{"name"})
+	if err != nil {
+		t.Fatalf("CompileUpsert() error = %v", err)
+	}
+	if want := `INSERT INTO "users" ("email", "name") VALUES (?, ?) ON CONFLICT DO UPDATE SET "name" = excluded."name"`; gotSQL != want {
+		t.Errorf("CompileUpsert() SQL = %q, want %q", gotSQL, want)
+	}
+	if !reflect.DeepEqual(gotArgs, []any{"a@b.c", "A"}) {
+		t.Errorf("CompileUpsert() args = %v", gotArgs)
+	}
+}
+
+func TestSQLiteGrammar_FullText(t *testing.T) {
+	g := dialect.SQLite()
+
+	search := func(columns ...string) *dialect.FullTextClause {
+		return &dialect.FullTextClause{Columns: columns, Query: "wireless OR bluetooth"}
+	}
+
+	t.Run("fts5 match", func(t *testing.T) {
+		builder := &mockBuilder{
+			table: "products_fts",
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search("products_fts")},
+			},
+			orders: []dialect.OrderClause{
+				{Direction: dialect.OrderDesc, FullText: search("products_fts")},
+			},
+		}
+		gotSQL, gotArgs, err := g.CompileSelect(builder)
+		if err != nil {
+			t.Fatalf("CompileSelect() error = %v", err)
+		}
+		if want := `SELECT * FROM "products_fts" WHERE "products_fts" MATCH ? ORDER BY -rank DESC`; gotSQL != want {
+			t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, want)
+		}
+		if !reflect.DeepEqual(gotArgs, []any{"wireless OR bluetooth"}) {
+			t.Errorf("CompileSelect() args = %v", gotArgs)
+		}
+	})
+
+	t.Run("multiple columns are unsupported", func(t *testing.T) {
+		builder := &mockBuilder{
+			table: "products_fts",
+			wheres: []dialect.WhereClause{
+				{Type: dialect.WhereTypeFullText, Boolean: dialect.WhereBooleanAnd, FullText: search("name", "description")},
+			},
+		}
+		if _, _, err := g.CompileSelect(builder); !errors.Is(err, dialect.ErrUnsupportedFeature) {
+			t.Errorf("CompileSelect() error = %v, want ErrUnsupportedFeature", err)
+		}
+	})
+}
+
+func TestSQLiteGrammar_Lock(t *testing.T) {
+	g := dialect.SQLite()
+
+	builder := &mockBuilder{table: "jobs", limit: intPtr(10), lock: &dialect.LockClause{Strength: dialect.LockUpdate, Wait: dialect.LockSkipLocked}}
+	gotSQL, _, err := g.CompileSelect(builder)
+	if err != nil {
+		t.Fatalf("CompileSelect() error = %v", err)
+	}
+	if want := `SELECT * FROM "jobs" LIMIT 10`; gotSQL != want {
+		t.Errorf("CompileSelect() SQL = %q, want %q", gotSQL, want)
+	}
+
+	builder.lock = &dialect.LockClause{Strength: "EXCLUSIVE"}
+	if _, _, err := g.CompileSelect(builder); !errors.Is(err, dialect.ErrInvalidLock) {
+		t.Errorf("CompileSelect() error = %v, want ErrInvalidLock", err)
+	}
+}
+
+func TestSQLiteGrammar_ClassifyError(t *testing.T) {
+	g := dialect.SQLite()
+
+	tests := []struct {
+		message string
+		want    dialect.ErrorClassification
+	}{
+		{"UNIQUE constraint failed: users.email", dialect.ErrorClassification{Kind: dialect.ErrorKindUniqueViolation, Column: "email"}},
+		{"NOT NULL constraint failed: users.name", dialect.ErrorClassification{Kind: dialect.ErrorKindNotNullViolation, Column: "name"}},
+		{"CHECK constraint failed: age_positive", dialect.ErrorClassification{Kind: dialect.ErrorKindCheckViolation, Constraint: "age_positive"}},
+		{"FOREIGN KEY constraint failed", dialect.ErrorClassification{Kind: dialect.ErrorKindForeignKeyViolation}},
+		{"database is locked", dialect.ErrorClassification{Kind: dialect.ErrorKindLockTimeout}},
+		{"no such table: users", dialect.ErrorClassification{Kind: dialect.ErrorKindUnknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			if got := g.ClassifyError(dialect.ErrorCode{Message: tt.message}); got != tt.want {
+				t.Errorf("ClassifyError() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,432 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	fluentsql "github.com/biyonik/go-fluent-sql"
+)
+
+func TestTransaction_Nested(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	boom := errors.New("card declined")
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		if tx.Depth() != 0 {
+			t.Errorf("outer Depth() = %d, want 0", tx.Depth())
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT orders"); err != nil {
+			return err
+		}
+
+		// Başarılı iç kapsam savepoint'i serbest bırakır
+		err := tx.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+			if tx.Depth() != 1 {
+				t.Errorf("inner Depth() = %d, want 1", tx.Depth())
+			}
+			return tx.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+				if tx.Depth() != 2 {
+					t.Errorf("innermost Depth() = %d, want 2", tx.Depth())
+				}
+				_, err := tx.ExecContext(ctx, "INSERT items")
+				return err
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		// Başarısız iç kapsam yalnızca kendi savepoint'ine döner
+		err = tx.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+			_, _ = tx.ExecContext(ctx, "INSERT payments")
+			return boom
+		})
+		if !errors.Is(err, boom) {
+			t.Errorf("inner Transaction() error = %v, want %v", err, boom)
+		}
+		if tx.Depth() != 0 || tx.IsClosed() {
+			t.Errorf("outer transaction after inner failure: depth = %d, closed = %v", tx.Depth(), tx.IsClosed())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"INSERT orders",
+		"SAVEPOINT `fluentsql_sp_1`",
+		"SAVEPOINT `fluentsql_sp_2`",
+		"INSERT items",
+		"RELEASE SAVEPOINT `fluentsql_sp_2`",
+		"RELEASE SAVEPOINT `fluentsql_sp_1`",
+		"SAVEPOINT `fluentsql_sp_3`",
+		"INSERT payments",
+		"ROLLBACK TO SAVEPOINT `fluentsql_sp_3`",
+		"COMMIT",
+	}
+	if got := fake.SQL(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("driver SQL =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestTransaction_NestedPanic(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() error = %v", err)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("panic was not propagated")
+			}
+		}()
+		_ = tx.Transaction(ctx, func(*fluentsql.Transaction) error {
+			panic("boom")
+		})
+	}()
+
+	if tx.Depth() != 0 || tx.IsClosed() {
+		t.Errorf("after panic: depth = %d, closed = %v", tx.Depth(), tx.IsClosed())
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	want := "BEGIN|SAVEPOINT `fluentsql_sp_1`|ROLLBACK TO SAVEPOINT `fluentsql_sp_1`|COMMIT"
+	if got := strings.Join(fake.SQL(), "|"); got != want {
+		t.Errorf("driver SQL = %q, want %q", got, want)
+	}
+}
+
+func TestTransaction_SavepointValidation(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() error = %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, name := range []string{"", "sp; DROP TABLE users", "a.b", "sp`x", "sp 1"} {
+		if err := tx.Savepoint(name); !errors.Is(err, fluentsql.ErrInvalidIdentifier) {
+			t.Errorf("Savepoint(%q) error = %v, want ErrInvalidIdentifier", name, err)
+		}
+		if err := tx.RollbackToContext(ctx, name); !errors.Is(err, fluentsql.ErrInvalidIdentifier) {
+			t.Errorf("RollbackToContext(%q) error = %v, want ErrInvalidIdentifier", name, err)
+		}
+		if err := tx.ReleaseSavepointContext(ctx, name); !errors.Is(err, fluentsql.ErrInvalidIdentifier) {
+			t.Errorf("ReleaseSavepointContext(%q) error = %v, want ErrInvalidIdentifier", name, err)
+		}
+	}
+	if got := fake.SQL(); len(got) != 1 {
+		t.Errorf("invalid savepoint names reached the driver: %q", got)
+	}
+
+	// Savepoint ifadeleri çağıranın context'i ile çalışır
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := tx.SavepointContext(canceled, "sp1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("SavepointContext(canceled) error = %v, want context.Canceled", err)
+	}
+}
+
+func TestTransaction_ContextPropagation(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	// Transaction'dan habersiz repository fonksiyonu
+	createOrder := func(ctx context.Context) error {
+		_, err := db.TableContext(ctx, "orders").InsertContext(ctx, map[string]any{"id": 1})
+		return err
+	}
+
+	if fluentsql.TxFromContext(ctx) != nil {
+		t.Fatal("TxFromContext(background) != nil")
+	}
+
+	var outer *fluentsql.Transaction
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		outer = tx
+		if fluentsql.TxFromContext(tx.Context()) != tx {
+			t.Error("tx.Context() does not carry the transaction")
+		}
+		txCtx := fluentsql.WithTx(ctx, tx)
+		if err := createOrder(txCtx); err != nil {
+			return err
+		}
+		// İç içe DB.Transaction dış transaction'a savepoint ile katılır
+		return db.Transaction(txCtx, func(inner *fluentsql.Transaction) error {
+			if inner != tx || inner.Depth() != 1 {
+				t.Errorf("nested DB.Transaction did not join: same = %v, depth = %d", inner == tx, inner.Depth())
+			}
+			return createOrder(txCtx)
+		})
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"INSERT INTO `orders` (`id`) VALUES (?)",
+		"SAVEPOINT `fluentsql_sp_1`",
+		"INSERT INTO `orders` (`id`) VALUES (?)",
+		"RELEASE SAVEPOINT `fluentsql_sp_1`",
+		"COMMIT",
+	}
+	if got := fake.SQL(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("driver SQL =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// Kapanmış transaction'lar yok sayılır; sorgu havuzda çalışır
+	if fluentsql.TxFromContext(outer.Context()) != nil {
+		t.Error("TxFromContext returned a committed transaction")
+	}
+	if err := createOrder(outer.Context()); err != nil {
+		t.Errorf("createOrder(after commit) error = %v", err)
+	}
+
+	// Başka bir DB'nin transaction'ına katılınmaz
+	otherSQL, otherFake := newFakeDB(t)
+	other := fluentsql.NewDB(otherSQL)
+	err = db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		_, err := other.TableContext(tx.Context(), "audit").InsertContext(ctx, map[string]any{"id": 1})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+	if got := otherFake.SQL(); len(got) != 1 || got[0] != "INSERT INTO `audit` (`id`) VALUES (?)" {
+		t.Errorf("other DB SQL = %q, want a single standalone insert", got)
+	}
+}
+
+func TestTransaction_Hooks(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	var events []string
+	record := func(name string) func() { return func() { events = append(events, name) } }
+	recordErr := func(name string) func(error) {
+		return func(err error) { events = append(events, fmt.Sprintf("%s(%v)", name, err)) }
+	}
+
+	boom := errors.New("payment failed")
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		tx.AfterCommit(record("commit-1"))
+		tx.BeforeCommit(func() error {
+			events = append(events, "before")
+			_, err := tx.ExecContext(ctx, "INSERT outbox")
+			return err
+		})
+		tx.AfterRollback(recordErr("rollback-outer"))
+
+		// Başarılı iç kapsamın hook'ları dış transaction'a geçer
+		_ = tx.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+			tx.AfterCommit(record("commit-inner"))
+			return nil
+		})
+		// Geri alınan iç kapsamın AfterRollback hook'u hemen çalışır, diğerleri atılır
+		_ = tx.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+			tx.AfterCommit(record("commit-discarded"))
+			tx.AfterRollback(recordErr("rollback-inner"))
+			return boom
+		})
+		tx.AfterCommit(record("commit-2"))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	want := "rollback-inner(payment failed) before commit-1 commit-inner commit-2"
+	if got := strings.Join(events, " "); got != want {
+		t.Errorf("hooks = %q, want %q", got, want)
+	}
+	if got := fake.SQL(); got[len(got)-2] != "INSERT outbox" || got[len(got)-1] != "COMMIT" {
+		t.Errorf("BeforeCommit did not run inside the transaction: %q", got)
+	}
+}
+
+func TestTransaction_HooksOnFailure(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	var events []string
+	boom := errors.New("boom")
+
+	// fn hatası → AfterRollback hatayla, AfterCommit hiç çalışmaz
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		tx.AfterCommit(func() { events = append(events, "commit") })
+		tx.AfterRollback(func(err error) { events = append(events, "rollback:"+err.Error()) })
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	// BeforeCommit hatası → commit yapılmaz
+	err = db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		tx.BeforeCommit(func() error { return boom })
+		tx.AfterCommit(func() { events = append(events, "commit") })
+		tx.AfterRollback(func(err error) { events = append(events, "rollback:before") })
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("Transaction() with failing BeforeCommit error = %v", err)
+	}
+
+	// Başarısız COMMIT → AfterRollback
+	fake.OnQuery(func(query string, _ []any) fakeResponse {
+		if query == "COMMIT" {
+			return fakeResponse{Err: boom}
+		}
+		return fakeResponse{}
+	})
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("BeginTx() error = %v", err)
+	}
+	tx.AfterRollback(func(err error) { events = append(events, "rollback:commit") })
+	if err := tx.Commit(); !errors.Is(err, boom) {
+		t.Errorf("Commit() error = %v", err)
+	}
+	// Hook'lar bir kez çalışır
+	_ = tx.Rollback()
+
+	want := "rollback:boom rollback:before rollback:commit"
+	if got := strings.Join(events, " "); got != want {
+		t.Errorf("hooks = %q, want %q", got, want)
+	}
+	want = "BEGIN ROLLBACK BEGIN ROLLBACK BEGIN COMMIT"
+	if got := strings.Join(fake.SQL(), " "); got != want {
+		t.Errorf("driver SQL = %q, want %q", got, want)
+	}
+}
+
+func TestTransaction_ReadOnlyAndIsolation(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	err := db.ReadOnlyTransaction(ctx, func(tx *fluentsql.Transaction) error {
+		if !tx.ReadOnly() || tx.IsolationLevel() != fluentsql.IsolationDefault {
+			t.Errorf("ReadOnly() = %v, IsolationLevel() = %v", tx.ReadOnly(), tx.IsolationLevel())
+		}
+		if _, err := tx.Table("users").CountContext(ctx); err != nil {
+			return err
+		}
+
+		// Yazmalar sunucuya gitmeden reddedilir
+		_, err := tx.Table("users").InsertContext(ctx, map[string]any{"name": "a"})
+		if !errors.Is(err, fluentsql.ErrReadOnlyTransaction) {
+			t.Errorf("InsertContext() error = %v, want ErrReadOnlyTransaction", err)
+		}
+		_, err = tx.Table("users").Where("id", "=", 1).UpdateContext(ctx, map[string]any{"name": "b"})
+		if !errors.Is(err, fluentsql.ErrReadOnlyTransaction) {
+			t.Errorf("UpdateContext() error = %v, want ErrReadOnlyTransaction", err)
+		}
+		_, err = tx.Table("users").Where("id", "=", 1).DeleteContext(ctx)
+		if !errors.Is(err, fluentsql.ErrReadOnlyTransaction) {
+			t.Errorf("DeleteContext() error = %v, want ErrReadOnlyTransaction", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ReadOnlyTransaction() error = %v", err)
+	}
+	want := "BEGIN|SELECT COUNT(*) FROM `users`|COMMIT"
+	if got := strings.Join(fake.SQL(), "|"); got != want {
+		t.Errorf("driver SQL = %q, want %q", got, want)
+	}
+
+	err = db.TransactionWithOptions(ctx, fluentsql.IsolationSerializable, func(tx *fluentsql.Transaction) error {
+		if tx.ReadOnly() || tx.IsolationLevel() != fluentsql.IsolationSerializable {
+			t.Errorf("ReadOnly() = %v, IsolationLevel() = %v", tx.ReadOnly(), tx.IsolationLevel())
+		}
+		_, err := tx.Table("users").InsertContext(ctx, map[string]any{"name": "a"})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("TransactionWithOptions() error = %v", err)
+	}
+
+	opts := fake.TxOptions()
+	if len(opts) != 2 || !opts[0].ReadOnly || opts[1].ReadOnly || opts[1].Isolation != driver.IsolationLevel(sql.LevelSerializable) {
+		t.Errorf("driver tx options = %+v", opts)
+	}
+}
+
+func TestTransaction_OptionsMismatch(t *testing.T) {
+	sqlDB, fake := newFakeDB(t)
+	db := fluentsql.NewDB(sqlDB)
+	ctx := context.Background()
+
+	called := false
+	mark := func(*fluentsql.Transaction) error {
+		called = true
+		return nil
+	}
+
+	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+		txCtx := fluentsql.WithTx(ctx, tx)
+
+		// Okuma-yazma transaction'a salt okunur katılım reddedilir
+		if err := db.ReadOnlyTransaction(txCtx, mark); !errors.Is(err, fluentsql.ErrTransactionOptionsMismatch) {
+			t.Errorf("ReadOnlyTransaction() error = %v, want ErrTransactionOptionsMismatch", err)
+		}
+		if err := db.TransactionWithOptions(txCtx, fluentsql.IsolationSerializable, mark); !errors.Is(err, fluentsql.ErrTransactionOptionsMismatch) {
+			t.Errorf("TransactionWithOptions() error = %v, want ErrTransactionOptionsMismatch", err)
+		}
+		if called {
+			t.Error("fn was called for a mismatched transaction")
+		}
+
+		// IsolationDefault tercih belirtmez; dış transaction'a katılır
+		return db.TransactionWithOptions(txCtx, fluentsql.IsolationDefault, mark)
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+	if !called {
+		t.Error("TransactionWithOptions(IsolationDefault) did not join the enclosing transaction")
+	}
+
+	called = false
+	err = db.ReadOnlyTransaction(ctx, func(tx *fluentsql.Transaction) error {
+		txCtx := fluentsql.WithTx(ctx, tx)
+		if err := db.TransactionWithOptions(txCtx, fluentsql.IsolationDefault, mark); !errors.Is(err, fluentsql.ErrTransactionOptionsMismatch) {
+			t.Errorf("TransactionWithOptions() in read-only error = %v, want ErrTransactionOptionsMismatch", err)
+		}
+		return db.ReadOnlyTransaction(txCtx, mark)
+	})
+	if err != nil {
+		t.Fatalf("ReadOnlyTransaction() error = %v", err)
+	}
+	if !called {
+		t.Error("ReadOnlyTransaction() did not join the enclosing read-only transaction")
+	}
+
+	want := "BEGIN|SAVEPOINT `fluentsql_sp_1`|RELEASE SAVEPOINT `fluentsql_sp_1`|COMMIT|BEGIN|SAVEPOINT `fluentsql_sp_1`|RELEASE SAVEPOINT `fluentsql_sp_1`|COMMIT"
+	if got := strings.Join(fake.SQL(), "|"); got != want {
+		t.Errorf("driver SQL = %q, want %q", got, want)
+	}
+}
@@ -3,9 +3,14 @@ package fluentsql
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/biyonik/go-fluent-sql/dialect"
+	"github.com/biyonik/go-fluent-sql/internal/validation"
 )
 
 // -----------------------------------------------------------------------------
@@ -40,15 +45,26 @@ import (
 // Ancak dikkat: Bu yapı thread-safe değildir. Her goroutine kendi Transaction
 // nesnesini kullanmalıdır.
 type Transaction struct {
-	tx      *sql.Tx
-	grammar dialect.Grammar
-	scanner Scanner
-	logger  Logger
-	debug   bool
-	prefix  string
+	tx         *sql.Tx
+	pool       *sql.DB // Transaction'ı açan DB'nin (primary) bağlantı havuzu
+	isolation  IsolationLevel
+	readOnly   bool
+	ctx        context.Context
+	grammar    dialect.Grammar
+	scanner    Scanner
+	logger     Logger
+	debug      bool
+	prefix     string
+	redact     []string
+	unprefixed []string
 
-	mu     sync.Mutex
-	closed bool
+	interceptors []Interceptor
+
+	mu         sync.Mutex
+	closed     bool
+	savepoints int        // Üretilen savepoint adları için sayaç
+	hooks      txHooks    // Dış transaction'ın hook'ları
+	scopes     []*txHooks // Açık iç içe kapsamların hook'ları (en içteki sonda)
 }
 
 // Table metodu, transaction kapsamında kullanılmak üzere yeni bir Builder üretir.
@@ -59,7 +75,12 @@ type Transaction struct {
 //	tx, _ := db.Begin()
 //	_, err := tx.Table("users").Where("id", "=", 1).Update(data)
 func (t *Transaction) Table(name string) *Builder {
-	return NewBuilder(t.tx, t.grammar, t.scanner).Table(name)
+	b := NewBuilder(t.tx, t.grammar, t.scanner).Table(name)
+	b.tx = t
+	b.logger, b.debug, b.redact = t.logger, t.debug, t.redact
+	b.prefix, b.unprefixed = t.prefix, t.unprefixed
+	b.interceptors = t.interceptors
+	return b
 }
 
 // Commit metodu, yapılan tüm işlemleri kalıcı hale getirir. Bir kez işlendiğinde
@@ -68,18 +89,36 @@ func (t *Transaction) Table(name string) *Builder {
 // Kullanım Amacı:
 // • İşlemlerin başarıyla tamamlandığını onaylamak
 // • Sistem bütünlüğünü korumak
+//
+// BeforeCommit hook'ları commit'ten hemen önce çalışır; biri hata dönerse
+// transaction rollback edilir. AfterCommit hook'ları yalnızca commit
+// başarılı olduğunda, commit başarısız olursa AfterRollback hook'ları çalışır.
 func (t *Transaction) Commit() error {
-	t.mu.Lock()
-	defer t.mu.Unlock()
+	if t.IsClosed() {
+		return ErrTxAlreadyClosed
+	}
+	if err := t.runBeforeCommit(); err != nil {
+		_ = t.rollback(err)
+		return WrapError("before commit", err)
+	}
 
+	t.mu.Lock()
 	if t.closed {
+		t.mu.Unlock()
 		return ErrTxAlreadyClosed
 	}
-
 	t.closed = true
-	if err := t.tx.Commit(); err != nil {
+	hooks := t.takeHooks()
+	t.mu.Unlock()
+
+	err := t.lifecycle(t.ctx, "commit", func(context.Context) error {
+		return t.tx.Commit()
+	})
+	if err != nil {
+		hooks.runAfterRollback(err)
 		return WrapError("commit transaction", err)
 	}
+	hooks.runAfterCommit()
 	return nil
 }
 
@@ -89,16 +128,29 @@ func (t *Transaction) Commit() error {
 // Kullanım Senaryosu:
 // • Hata oluştuğunda işlemi geri almak
 // • Bir adım yanlış gittiğinde sistem tutarlılığını korumak
+//
+// AfterRollback hook'ları nil hata ile çağrılır; DB.Transaction ise rollback'e
+// neden olan hatayı iletir.
 func (t *Transaction) Rollback() error {
-	t.mu.Lock()
-	defer t.mu.Unlock()
+	return t.rollback(nil)
+}
 
+// rollback, transaction'ı geri alır ve AfterRollback hook'larını cause ile çalıştırır.
+func (t *Transaction) rollback(cause error) error {
+	t.mu.Lock()
 	if t.closed {
+		t.mu.Unlock()
 		return nil // Rollback is idempotent
 	}
-
 	t.closed = true
-	if err := t.tx.Rollback(); err != nil {
+	hooks := t.takeHooks()
+	t.mu.Unlock()
+
+	err := t.lifecycle(t.ctx, "rollback", func(context.Context) error {
+		return t.tx.Rollback()
+	})
+	hooks.runAfterRollback(cause)
+	if err != nil {
 		if err == sql.ErrTxDone {
 			return nil
 		}
@@ -107,6 +159,29 @@ func (t *Transaction) Rollback() error {
 	return nil
 }
 
+// Context, transaction'ın başlatıldığı context'i döndürür. Interceptor'lar
+// "begin" sırasında next'e zenginleştirilmiş bir context (ör. tracing span'i)
+// vererek transaction boyunca taşınacak değerleri buraya ekleyebilir.
+// Commit, Rollback ve savepoint işlemleri bu context ile yürütülür.
+//
+// Dönen context transaction'ın kendisini de taşır (bkz. WithTx); aşağıdaki
+// katmanlara verildiğinde DB.TableContext ve DB.Transaction bu transaction'a katılır.
+func (t *Transaction) Context() context.Context {
+	return t.ctx
+}
+
+// IsolationLevel, transaction'ın izolasyon seviyesini döndürür.
+// IsolationDefault, veritabanının varsayılanının kullanıldığını belirtir.
+func (t *Transaction) IsolationLevel() IsolationLevel {
+	return t.isolation
+}
+
+// ReadOnly, transaction'ın salt okunur açılıp açılmadığını bildirir.
+// Salt okunur transaction'larda Builder yazmaları ErrReadOnlyTransaction döndürür.
+func (t *Transaction) ReadOnly() bool {
+	return t.readOnly
+}
+
 // IsClosed transaction'ın commit ya da rollback sonrası kapanıp kapanmadığını bildirir.
 // Bu, işlem akışını kontrol ederken önemli bir güvenlik kilidi işlevi görür.
 func (t *Transaction) IsClosed() bool {
@@ -129,7 +204,24 @@ func (t *Transaction) ExecContext(ctx context.Context, query string, args ...any
 	}
 	t.mu.Unlock()
 
-	return t.tx.ExecContext(ctx, query, args...)
+	out, err := t.run(ctx, "exec", MethodExec, query, args)
+	return out.Result, err
+}
+
+// ExecStatement — DB.ExecStatement'in transaction karşılığıdır; op ve table
+// interceptor'lara verilen QueryInfo'ya yazılır.
+func (t *Transaction) ExecStatement(ctx context.Context, op, table, query string, args ...any) (sql.Result, error) {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil, ErrTxAlreadyClosed
+	}
+	t.mu.Unlock()
+
+	info := t.info(op, MethodExec, query, args)
+	info.Table = table
+	out, err := runQuery(ctx, t.tx, t.grammar, t.interceptors, t.logger, t.debug, info)
+	return out.Result, err
 }
 
 // QueryContext — result set döndüren SELECT benzeri işlemler için kullanılır.
@@ -142,20 +234,69 @@ func (t *Transaction) QueryContext(ctx context.Context, query string, args ...an
 	}
 	t.mu.Unlock()
 
-	return t.tx.QueryContext(ctx, query, args...)
+	out, err := t.run(ctx, "query", MethodQuery, query, args)
+	return out.Rows, err
 }
 
 // QueryRowContext — tek satır dönen sorgular içindir. Örneğin LIMIT 1,
 // COUNT(), MAX() gibi yapılar için tercih edilir.
+//
+// Bir interceptor sorguyu hatayla kısa devre yaparsa sorgu veritabanına
+// gönderilmez; dönen satırın Scan'i bu hatayı sarmalayarak döndürür
+// (errors.Is ile yakalanabilir). Kapalı transaction'da Scan sql.ErrTxDone döner.
 func (t *Transaction) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
 	t.mu.Lock()
 	if t.closed {
 		t.mu.Unlock()
-		return t.tx.QueryRowContext(ctx, "SELECT 1 WHERE 1=0")
+		return failedRow(ctx, t.tx, query, ErrTxAlreadyClosed)
 	}
 	t.mu.Unlock()
 
-	return t.tx.QueryRowContext(ctx, query, args...)
+	out, err := t.run(ctx, "query", MethodQueryRow, query, args)
+	if err != nil {
+		return failedRow(ctx, t.tx, query, err)
+	}
+	return out.Row
+}
+
+// failedRow, Scan'i err döndüren bir *sql.Row üretir. *sql.Row dışarıdan
+// hatayla kurulamadığından Value() metodu err döndüren tek bir argüman
+// verilir: database/sql argümanları sürücüye sorgu göndermeden önce dönüştürür
+// ve dönüşüm hatasını %w ile sarar, yani veritabanına hiçbir şey gitmez.
+func failedRow(ctx context.Context, tx *sql.Tx, query string, err error) *sql.Row {
+	return tx.QueryRowContext(ctx, query, errValuer{err})
+}
+
+// errValuer, Value() çağrıldığında taşıdığı hatayı döndürür.
+type errValuer struct{ err error }
+
+func (v errValuer) Value() (driver.Value, error) { return nil, v.err }
+
+// run, ham transaction sorgusunu interceptor zincirinden geçirerek çalıştırır.
+func (t *Transaction) run(ctx context.Context, op string, method QueryMethod, query string, args []any) (QueryOutcome, error) {
+	return runQuery(ctx, t.tx, t.grammar, t.interceptors, t.logger, t.debug, t.info(op, method, query, args))
+}
+
+// lifecycle, begin/commit/rollback adımını MethodTx olarak interceptor
+// zincirinden geçirir. fn, zincirin en içinde gerçek işlemi yapar.
+func (t *Transaction) lifecycle(ctx context.Context, op string, fn func(ctx context.Context) error) error {
+	_, err := chain(t.interceptors, func(ctx context.Context, _ QueryInfo) (QueryOutcome, error) {
+		return QueryOutcome{}, classifyError(t.grammar, fn(ctx))
+	})(ctx, t.info(op, MethodTx, "", nil))
+	return err
+}
+
+// info, transaction kapsamındaki bir işlem için QueryInfo üretir.
+func (t *Transaction) info(op string, method QueryMethod, query string, args []any) QueryInfo {
+	return QueryInfo{
+		Operation:     op,
+		Method:        method,
+		Dialect:       t.grammar.Name(),
+		SQL:           query,
+		Args:          args,
+		InTransaction: true,
+		Tx:            t,
+	}
 }
 
 // Grammar — transaction seviyesinde kullanılan SQL sözdizimini döndürür.
@@ -171,8 +312,45 @@ func (t *Transaction) Scanner() Scanner {
 // Savepoint — büyük transaction blokları arasında güvenli dönüş noktası oluşturur.
 // Tüm işlemi bozmak yerine yalnızca belirli bölümü geri almak için kullanılır.
 //
+// Ad, identifier kurallarına göre doğrulanır ve gramerin tırnaklarıyla sarılır;
+// geçersiz adlar ErrInvalidIdentifier ile eşleşen bir ValidationError döndürür.
+//
 // Not: Her veritabanı savepoint desteklemez.
 func (t *Transaction) Savepoint(name string) error {
+	return t.SavepointContext(t.ctx, name)
+}
+
+// SavepointContext, Savepoint'in context alan sürümüdür.
+func (t *Transaction) SavepointContext(ctx context.Context, name string) error {
+	return t.savepointExec(ctx, "savepoint", name)
+}
+
+// RollbackTo — transaction’ı tamamen geri almadan yalnızca belirli savepoint’e
+// dönüş sağlar. Büyük sistemlerde geri dönüş maliyetini minimize eder.
+func (t *Transaction) RollbackTo(name string) error {
+	return t.RollbackToContext(t.ctx, name)
+}
+
+// RollbackToContext, RollbackTo'nun context alan sürümüdür.
+func (t *Transaction) RollbackToContext(ctx context.Context, name string) error {
+	return t.savepointExec(ctx, "rollback_to_savepoint", name)
+}
+
+// ReleaseSavepoint — oluşturulan savepoint’i serbest bırakır.
+// Bu işlem transaction’ı bitirmez, yalnızca savepoint'i temizler.
+func (t *Transaction) ReleaseSavepoint(name string) error {
+	return t.ReleaseSavepointContext(t.ctx, name)
+}
+
+// ReleaseSavepointContext, ReleaseSavepoint'in context alan sürümüdür.
+func (t *Transaction) ReleaseSavepointContext(ctx context.Context, name string) error {
+	return t.savepointExec(ctx, "release_savepoint", name)
+}
+
+// savepointExec, op'a karşılık gelen savepoint ifadesini (SAVEPOINT,
+// ROLLBACK TO, RELEASE) gramerle derler ve interceptor zincirinden geçirerek
+// ExecContext ile çalıştırır.
+func (t *Transaction) savepointExec(ctx context.Context, op, name string) error {
 	t.mu.Lock()
 	if t.closed {
 		t.mu.Unlock()
@@ -180,57 +358,113 @@ func (t *Transaction) Savepoint(name string) error {
 	}
 	t.mu.Unlock()
 
-	if name == "" {
-		return NewValidationError("identifier", name, "savepoint name cannot be empty")
+	if err := validateSavepointName(name); err != nil {
+		return err
 	}
 
-	_, err := t.tx.Exec("SAVEPOINT " + name)
+	var statement, action string
+	var err error
+	switch op {
+	case "savepoint":
+		action = "create savepoint"
+		statement, err = t.grammar.CompileSavepoint(name)
+	case "rollback_to_savepoint":
+		action = "rollback to savepoint"
+		statement, err = t.grammar.CompileRollbackToSavepoint(name)
+	default:
+		action = "release savepoint"
+		statement, err = t.grammar.CompileReleaseSavepoint(name)
+	}
 	if err != nil {
-		return WrapError("create savepoint", err)
+		return WrapError(action, err)
+	}
+	if statement == "" {
+		return nil // Gramer bu adımı desteklemiyor (ör. SQL Server'da RELEASE)
 	}
-	return nil
-}
 
-// RollbackTo — transaction’ı tamamen geri almadan yalnızca belirli savepoint’e
-// dönüş sağlar. Büyük sistemlerde geri dönüş maliyetini minimize eder.
-func (t *Transaction) RollbackTo(name string) error {
-	t.mu.Lock()
-	if t.closed {
-		t.mu.Unlock()
-		return ErrTxAlreadyClosed
+	if _, err := t.run(ctx, op, MethodExec, statement, nil); err != nil {
+		return WrapError(action, err)
 	}
-	t.mu.Unlock()
+	return nil
+}
 
+// validateSavepointName, savepoint adının nitelikli olmayan geçerli bir
+// identifier olduğunu doğrular.
+func validateSavepointName(name string) error {
 	if name == "" {
 		return NewValidationError("identifier", name, "savepoint name cannot be empty")
 	}
-
-	_, err := t.tx.Exec("ROLLBACK TO SAVEPOINT " + name)
-	if err != nil {
-		return WrapError("rollback to savepoint", err)
+	if err := validation.ValidateIdentifier(name); err != nil {
+		var idErr *validation.IdentifierError
+		if errors.As(err, &idErr) {
+			return NewValidationError("identifier", name, idErr.Reason)
+		}
+		return NewValidationError("identifier", name, err.Error())
+	}
+	if strings.Contains(name, ".") {
+		return NewValidationError("identifier", name, "savepoint name cannot be qualified")
 	}
 	return nil
 }
 
-// ReleaseSavepoint — oluşturulan savepoint’i serbest bırakır.
-// Bu işlem transaction’ı bitirmez, yalnızca savepoint'i temizler.
-func (t *Transaction) ReleaseSavepoint(name string) error {
+// Transaction — fn'i bu transaction içinde, otomatik üretilen bir savepoint
+// ile sarılmış iç içe bir transaction olarak çalıştırır. fn başarılı olursa
+// savepoint serbest bırakılır; hata veya panic durumunda yalnızca savepoint'e
+// kadar olan değişiklikler geri alınır, dış transaction açık kalır.
+//
+// Böylece kütüphane kodu, çağıranın bir transaction içinde olup olmadığını
+// bilmeden "transaction başlatabilir".
+//
+// Örnek:
+//
+//	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+//	    _, _ = tx.Table("orders").InsertContext(ctx, order)
+//	    // Ödeme başarısız olursa yalnızca bu bölüm geri alınır
+//	    _ = tx.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+//	        return chargeCard(ctx, tx, order)
+//	    })
+//	    return nil
+//	})
+func (t *Transaction) Transaction(ctx context.Context, fn func(*Transaction) error) error {
 	t.mu.Lock()
-	if t.closed {
-		t.mu.Unlock()
-		return ErrTxAlreadyClosed
-	}
+	t.savepoints++
+	name := fmt.Sprintf("fluentsql_sp_%d", t.savepoints)
 	t.mu.Unlock()
 
-	if name == "" {
-		return NewValidationError("identifier", name, "savepoint name cannot be empty")
+	if err := t.savepointExec(ctx, "savepoint", name); err != nil {
+		return err
 	}
+	t.pushScope()
 
-	_, err := t.tx.Exec("RELEASE SAVEPOINT " + name)
-	if err != nil {
-		return WrapError("release savepoint", err)
+	// Panic güvenliği → yalnızca savepoint'e kadar geri alınır
+	defer func() {
+		if p := recover(); p != nil {
+			_ = t.savepointExec(ctx, "rollback_to_savepoint", name)
+			t.popScope().runAfterRollback(panicError(p))
+			panic(p)
+		}
+	}()
+
+	if err := fn(t); err != nil {
+		rbErr := t.savepointExec(ctx, "rollback_to_savepoint", name)
+		t.popScope().runAfterRollback(err)
+		if rbErr != nil {
+			return WrapError("rollback to savepoint after error", rbErr)
+		}
+		return err
 	}
-	return nil
+
+	// Kapsamın değişiklikleri dış transaction'a geçer, hook'ları da
+	t.mergeScope(t.popScope())
+	return t.savepointExec(ctx, "release_savepoint", name)
+}
+
+// Depth, iç içe transaction derinliğini döndürür: dış transaction'da 0,
+// Transaction ile açılan her iç kapsamda bir fazlası.
+func (t *Transaction) Depth() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.scopes)
 }
 
 // Tx — alttaki *sql.Tx* referansına doğrudan erişim sağlar.
@@ -0,0 +1,73 @@
+package fluentsql
+
+import "context"
+
+// -----------------------------------------------------------------------------
+//  Context Üzerinden Taşınan Transaction
+//
+//  Derin çağrı zincirlerinde *Transaction'ı her fonksiyon imzasına eklemek
+//  yerine transaction context'e konabilir. WithTx ile işaretlenen context'i
+//  alan kod:
+//
+//   • DB.TableContext ile, varsa dış transaction'a bağlı bir Builder alır,
+//   • DB.Transaction ile "transaction başlattığında" dış transaction içinde
+//     savepoint'li iç içe bir kapsam açar.
+//
+//  BeginTx ile başlatılan her transaction'ın Context() değeri kendisini
+//  taşır; DB.Transaction içinde tx.Context() doğrudan aşağıya verilebilir.
+//  Commit veya rollback edilmiş transaction'lar yok sayılır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// txContextKey, context'te transaction'ı saklamak için kullanılan anahtardır.
+type txContextKey struct{}
+
+// WithTx, tx'i taşıyan yeni bir context döndürür.
+//
+// Örnek:
+//
+//	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+//	    return orders.Create(fluentsql.WithTx(ctx, tx), order)
+//	})
+//
+//	// Repository, transaction'dan habersizdir
+//	func (r *OrderRepo) Create(ctx context.Context, o Order) error {
+//	    _, err := r.db.TableContext(ctx, "orders").InsertContext(ctx, o.Map())
+//	    return err
+//	}
+func WithTx(ctx context.Context, tx *Transaction) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext, context'teki transaction'ı döndürür. Transaction yoksa
+// veya kapanmışsa nil döner.
+func TxFromContext(ctx context.Context) *Transaction {
+	tx, _ := ctx.Value(txContextKey{}).(*Transaction)
+	if tx == nil || tx.IsClosed() {
+		return nil
+	}
+	return tx
+}
+
+// contextTx, context'teki açık transaction bu DB'ye aitse onu döndürür.
+// Başka bir bağlantı havuzunda açılmış transaction'lara katılınmaz.
+func (d *DB) contextTx(ctx context.Context) *Transaction {
+	if tx := TxFromContext(ctx); tx != nil && tx.pool == d.DB {
+		return tx
+	}
+	return nil
+}
+
+// TableContext, Table'ın context'e duyarlı sürümüdür. Context bu DB'de
+// açılmış bir transaction taşıyorsa Builder o transaction'a bağlanır
+// (tx.Table ile aynı); aksi hâlde DB.Table gibi bağlantı havuzunu kullanır.
+func (d *DB) TableContext(ctx context.Context, name string) *Builder {
+	if tx := d.contextTx(ctx); tx != nil {
+		return tx.Table(name)
+	}
+	return d.Table(name)
+}
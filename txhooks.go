@@ -0,0 +1,161 @@
+package fluentsql
+
+import "fmt"
+
+// -----------------------------------------------------------------------------
+//  Transaction Hook'ları — Commit Sonrası Yan Etkiler
+//
+//  Domain event yayınlamak, cache temizlemek veya e-posta göndermek gibi işler
+//  yalnızca veri gerçekten kalıcı olduğunda yapılmalıdır. Hook'lar bu işleri
+//  transaction'a bağlar:
+//
+//   • BeforeCommit  — COMMIT'ten hemen önce, transaction hâlâ açıkken çalışır.
+//                     Hata dönerse commit yapılmaz, transaction rollback edilir.
+//   • AfterCommit   — COMMIT başarılı olduktan sonra çalışır.
+//   • AfterRollback — Transaction geri alındığında (veya commit başarısız
+//                     olduğunda) nedeni ile birlikte çalışır.
+//
+//  Hook'lar kayıt sırasıyla ve en fazla bir kez çalışır. İç içe kapsamlarda
+//  (Transaction.Transaction) kaydedilen hook'lar o kapsama aittir: kapsam
+//  başarılı olursa dış transaction'a devredilir, savepoint'e geri dönülürse
+//  AfterRollback hook'ları hemen çalışır, diğerleri atılır.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// txHooks, tek bir kapsamın (dış transaction veya iç içe savepoint) hook'larıdır.
+type txHooks struct {
+	beforeCommit  []func() error
+	afterCommit   []func()
+	afterRollback []func(error)
+}
+
+// BeforeCommit, commit'ten hemen önce çalışacak bir hook ekler. Hook
+// transaction'ı kullanabilir (ör. outbox tablosuna yazmak). Hata dönerse
+// sonraki hook'lar çalışmaz, transaction rollback edilir ve Commit hatayı döndürür.
+func (t *Transaction) BeforeCommit(fn func() error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	scope := t.scope()
+	scope.beforeCommit = append(scope.beforeCommit, fn)
+}
+
+// AfterCommit, commit başarıyla tamamlandıktan sonra çalışacak bir hook ekler.
+//
+// Örnek:
+//
+//	err := db.Transaction(ctx, func(tx *fluentsql.Transaction) error {
+//	    if _, err := tx.Table("orders").InsertContext(ctx, order); err != nil {
+//	        return err
+//	    }
+//	    tx.AfterCommit(func() { events.Publish(OrderPlaced{ID: order["id"]}) })
+//	    return nil
+//	})
+func (t *Transaction) AfterCommit(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	scope := t.scope()
+	scope.afterCommit = append(scope.afterCommit, fn)
+}
+
+// AfterRollback, kapsam geri alındığında çalışacak bir hook ekler. Hook'a
+// rollback'e neden olan hata verilir; doğrudan Rollback çağrılarında nil'dir.
+func (t *Transaction) AfterRollback(fn func(error)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	scope := t.scope()
+	scope.afterRollback = append(scope.afterRollback, fn)
+}
+
+// scope, hook'ların kaydedileceği en içteki kapsamı döndürür. t.mu tutulmalıdır.
+func (t *Transaction) scope() *txHooks {
+	if n := len(t.scopes); n > 0 {
+		return t.scopes[n-1]
+	}
+	return &t.hooks
+}
+
+// pushScope, iç içe bir kapsam açar.
+func (t *Transaction) pushScope() {
+	t.mu.Lock()
+	t.scopes = append(t.scopes, &txHooks{})
+	t.mu.Unlock()
+}
+
+// popScope, en içteki kapsamı kapatır ve hook'larını döndürür.
+func (t *Transaction) popScope() *txHooks {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := len(t.scopes)
+	scope := t.scopes[n-1]
+	t.scopes = t.scopes[:n-1]
+	return scope
+}
+
+// mergeScope, başarılı bir iç kapsamın hook'larını bir üst kapsama ekler.
+func (t *Transaction) mergeScope(h *txHooks) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	parent := t.scope()
+	parent.beforeCommit = append(parent.beforeCommit, h.beforeCommit...)
+	parent.afterCommit = append(parent.afterCommit, h.afterCommit...)
+	parent.afterRollback = append(parent.afterRollback, h.afterRollback...)
+}
+
+// takeHooks, açık kalmış kapsamlar dahil tüm hook'ları alır ve transaction'dan
+// siler; böylece her hook en fazla bir kez çalışır. t.mu tutulmalıdır.
+func (t *Transaction) takeHooks() *txHooks {
+	all := t.hooks
+	for _, h := range t.scopes {
+		all.beforeCommit = append(all.beforeCommit, h.beforeCommit...)
+		all.afterCommit = append(all.afterCommit, h.afterCommit...)
+		all.afterRollback = append(all.afterRollback, h.afterRollback...)
+	}
+	t.hooks, t.scopes = txHooks{}, nil
+	return &all
+}
+
+// runBeforeCommit, BeforeCommit hook'larını sırayla çalıştırır. Hook'ların
+// çalışırken eklediği yeni BeforeCommit hook'ları da çalıştırılır.
+func (t *Transaction) runBeforeCommit() error {
+	for {
+		t.mu.Lock()
+		hooks := t.hooks.beforeCommit
+		t.hooks.beforeCommit = nil
+		t.mu.Unlock()
+
+		if len(hooks) == 0 {
+			return nil
+		}
+		for _, fn := range hooks {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// runAfterCommit, AfterCommit hook'larını sırayla çalıştırır.
+func (h *txHooks) runAfterCommit() {
+	for _, fn := range h.afterCommit {
+		fn()
+	}
+}
+
+// runAfterRollback, AfterRollback hook'larını sırayla cause ile çalıştırır.
+func (h *txHooks) runAfterRollback(cause error) {
+	for _, fn := range h.afterRollback {
+		fn(cause)
+	}
+}
+
+// panicError, recover edilen değeri AfterRollback hook'larına verilecek hataya çevirir.
+func panicError(p any) error {
+	if err, ok := p.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+	return fmt.Errorf("panic: %v", p)
+}
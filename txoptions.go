@@ -0,0 +1,85 @@
+package fluentsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// -----------------------------------------------------------------------------
+//  Salt Okunur ve İzolasyon Seviyeli Transaction'lar
+//
+//  BeginTx'in sql.TxOptions parametresi için kısayollar sağlar:
+//
+//   • ReadOnlyTransaction, raporlama gibi yalnızca okuyan işler içindir.
+//     Cluster tanımlıysa transaction bir replica'da açılır; Builder yazmaları
+//     (Insert, Update, Delete ...) SQL sunucuya gitmeden ErrReadOnlyTransaction
+//     ile reddedilir.
+//   • TransactionWithOptions, izolasyon seviyesini belirler (ör. para
+//     transferleri için IsolationSerializable).
+//
+//  Her ikisi de DB.Transaction gibi commit/rollback, hook ve WithRetryPolicy
+//  davranışlarını korur. Context açık bir transaction taşıyorsa yeni
+//  transaction açılmaz; fn dış transaction'a savepoint ile katılır. Dış
+//  transaction'ın salt okunurluğu veya izolasyon seviyesi istenenden farklıysa
+//  katılmak yerine ErrTransactionOptionsMismatch döner.
+//
+//  -- @author   Ahmet ALTUN
+//  -- @github   github.com/biyonik
+//  -- @linkedin linkedin.com/in/biyonik
+//  -- @email    ahmet.altun60@gmail.com
+// -----------------------------------------------------------------------------
+
+// IsolationLevel, transaction izolasyon seviyesidir (sql.IsolationLevel ile aynı tür).
+type IsolationLevel = sql.IsolationLevel
+
+// İzolasyon seviyeleri.
+const (
+	IsolationDefault         = sql.LevelDefault
+	IsolationReadUncommitted = sql.LevelReadUncommitted
+	IsolationReadCommitted   = sql.LevelReadCommitted
+	IsolationRepeatableRead  = sql.LevelRepeatableRead
+	IsolationSerializable    = sql.LevelSerializable
+)
+
+// ReadOnlyTransaction, fn'i salt okunur bir transaction içinde çalıştırır.
+//
+// Örnek:
+//
+//	err := db.ReadOnlyTransaction(ctx, func(tx *fluentsql.Transaction) error {
+//	    if err := tx.Table("orders").Where("day", "=", day).GetContext(ctx, &orders); err != nil {
+//	        return err
+//	    }
+//	    return tx.Table("refunds").Where("day", "=", day).GetContext(ctx, &refunds)
+//	})
+func (d *DB) ReadOnlyTransaction(ctx context.Context, fn func(*Transaction) error) error {
+	return d.transactionWithRetry(ctx, d.retry, &sql.TxOptions{ReadOnly: true}, fn)
+}
+
+// TransactionWithOptions, fn'i verilen izolasyon seviyesindeki bir
+// transaction içinde çalıştırır.
+//
+// Örnek:
+//
+//	err := db.TransactionWithOptions(ctx, fluentsql.IsolationSerializable, func(tx *fluentsql.Transaction) error {
+//	    return transfer(ctx, tx, from, to, amount)
+//	})
+func (d *DB) TransactionWithOptions(ctx context.Context, level IsolationLevel, fn func(*Transaction) error) error {
+	return d.transactionWithRetry(ctx, d.retry, &sql.TxOptions{Isolation: level}, fn)
+}
+
+// matchTxOptions, context'teki transaction'ın istenen seçeneklerle açılıp
+// açılmadığını denetler. opts nil ise (DB.Transaction) her transaction'a
+// katılınır; IsolationDefault izolasyon seviyesi için tercih belirtmez.
+func matchTxOptions(tx *Transaction, opts *sql.TxOptions) error {
+	if opts == nil {
+		return nil
+	}
+	if opts.ReadOnly != tx.readOnly {
+		return fmt.Errorf("%w: read-only = %t, enclosing read-only = %t", ErrTransactionOptionsMismatch, opts.ReadOnly, tx.readOnly)
+	}
+	if opts.Isolation != IsolationDefault && opts.Isolation != tx.isolation {
+		return fmt.Errorf("%w: isolation %s, enclosing isolation %s", ErrTransactionOptionsMismatch, opts.Isolation, tx.isolation)
+	}
+	return nil
+}